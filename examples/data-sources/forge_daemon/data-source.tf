@@ -1,4 +0,0 @@
-data "laravelforge_daemon" "example" {
-  id        = "some-value"
-  server_id = "some-value"
-}
@@ -0,0 +1,9 @@
+data "laravelforge_background_process_log" "queue" {
+  server             = 1
+  background_process = 2
+  lines              = 50
+}
+
+output "queue_log" {
+  value = data.laravelforge_background_process_log.queue.content
+}
@@ -0,0 +1,8 @@
+data "laravelforge_servers" "production" {
+  region        = "ams3"
+  provider_name = "ocean2"
+}
+
+output "production_server_ips" {
+  value = [for server in data.laravelforge_servers.production.servers : server.ip_address]
+}
@@ -1,3 +1,4 @@
 provider "laravelforge" {
-  token = "API_TOKEN"
+  api_token    = "API_TOKEN"
+  organization = "my-organization"
 }
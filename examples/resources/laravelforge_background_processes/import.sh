@@ -0,0 +1,3 @@
+# The import ID is organization/server/background_process. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_background_processes.example acme/123/1001
@@ -0,0 +1,3 @@
+# The import ID is organization/server/site/repository. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_composer_credentials.example acme/123/456/repo.packagist.com
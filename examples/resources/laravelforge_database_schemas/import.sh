@@ -0,0 +1,3 @@
+# The import ID is organization/server/database. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_database_schemas.example acme/123/1001
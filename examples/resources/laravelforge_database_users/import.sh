@@ -0,0 +1,3 @@
+# The import ID is organization/server/database_user. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_database_users.example acme/123/1001
@@ -0,0 +1,3 @@
+# The import ID is organization/server/site/deployment_webhook. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_deployment_webhooks.example acme/123/456/1001
@@ -0,0 +1,15 @@
+resource "laravelforge_site_domains" "www" {
+  server                    = laravelforge_servers.app.server
+  site                      = laravelforge_sites.app.site
+  name                      = "www.example.com"
+  allow_wildcard_subdomains = false
+  www_redirect_type         = "none"
+}
+
+# Serve the site on www.example.com by default.
+resource "laravelforge_domain_action" "primary" {
+  server        = laravelforge_site_domains.www.server
+  site          = laravelforge_site_domains.www.site
+  domain_record = laravelforge_site_domains.www.domain_record
+  action        = "mark-as-primary"
+}
@@ -0,0 +1,24 @@
+resource "laravelforge_domain_certificates" "app" {
+  server        = laravelforge_servers.app.server
+  site          = laravelforge_sites.app.site
+  domain_record = laravelforge_site_domains.app.domain_record
+  type          = "existing"
+  activate      = false
+
+  existing = {
+    certificate = file("${path.module}/app.crt")
+    key         = file("${path.module}/app.key")
+  }
+}
+
+# Activate the certificate again whenever it is rotated.
+resource "laravelforge_domain_certificate_action" "enable" {
+  server        = laravelforge_domain_certificates.app.server
+  site          = laravelforge_domain_certificates.app.site
+  domain_record = laravelforge_domain_certificates.app.domain_record
+  action        = "enable"
+
+  triggers = {
+    certificate = laravelforge_domain_certificates.app.created_at
+  }
+}
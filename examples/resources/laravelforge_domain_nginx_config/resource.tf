@@ -0,0 +1,6 @@
+resource "laravelforge_domain_nginx_config" "www" {
+  server        = laravelforge_servers.app.server
+  site          = laravelforge_sites.app.site
+  domain_record = laravelforge_site_domains.www.domain_record
+  content       = file("${path.module}/www.nginx.conf")
+}
@@ -0,0 +1,3 @@
+# The import ID is organization/server/rule. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_firewall_rules.example acme/123/1001
@@ -0,0 +1,3 @@
+# The import ID is organization/server/site/heartbeat. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_heartbeats.example acme/123/456/1001
@@ -0,0 +1,3 @@
+# The import ID is organization/server/monitor. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_monitors.example acme/123/1001
@@ -0,0 +1,3 @@
+# The import ID is organization/server/nginx_template. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_nginx_templates.example acme/123/1001
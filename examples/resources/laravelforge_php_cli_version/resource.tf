@@ -0,0 +1,4 @@
+resource "laravelforge_php_cli_version" "app" {
+  server  = laravelforge_servers.app.server
+  version = "8.3"
+}
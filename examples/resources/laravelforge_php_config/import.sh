@@ -0,0 +1,3 @@
+# The import ID is organization/server/php_version/kind, where kind is fpm, cli
+# or pool. The organization may be left out when it is set on the provider.
+terraform import laravelforge_php_config.example acme/123/456/pool
@@ -0,0 +1,13 @@
+resource "laravelforge_php_config" "pool" {
+  server      = laravelforge_servers.app.server
+  php_version = laravelforge_php_versions.php83.php_version
+  kind        = "pool"
+  content     = templatefile("${path.module}/www.conf.tftpl", { max_children = 20 })
+}
+
+resource "laravelforge_php_config" "fpm" {
+  server      = laravelforge_servers.app.server
+  php_version = laravelforge_php_versions.php83.php_version
+  kind        = "fpm"
+  content     = file("${path.module}/php-fpm.ini")
+}
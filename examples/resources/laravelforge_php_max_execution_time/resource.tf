@@ -0,0 +1,4 @@
+resource "laravelforge_php_max_execution_time" "app" {
+  server             = laravelforge_servers.app.server
+  max_execution_time = 60
+}
@@ -0,0 +1,4 @@
+resource "laravelforge_php_max_upload_size" "app" {
+  server          = laravelforge_servers.app.server
+  max_upload_size = 100
+}
@@ -0,0 +1,3 @@
+# The import ID is organization/server. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_php_opcache.example acme/123
@@ -0,0 +1,4 @@
+resource "laravelforge_php_site_version" "app" {
+  server  = laravelforge_servers.app.server
+  version = "8.3"
+}
@@ -0,0 +1,3 @@
+# The import ID is organization/recipe/log. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_recipe_runs.example acme/34/9
@@ -0,0 +1,3 @@
+# The import ID is organization/recipe. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_recipes.example acme/34
@@ -0,0 +1,3 @@
+# The import ID is organization/credential/region/vpc_id. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_region_vpcs.example acme/56/ams3/0d6f2d36
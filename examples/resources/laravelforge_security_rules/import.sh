@@ -0,0 +1,3 @@
+# The import ID is organization/server/site/security_rule. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_security_rules.example acme/123/456/1001
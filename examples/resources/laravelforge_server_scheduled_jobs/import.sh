@@ -0,0 +1,3 @@
+# The import ID is organization/server/job. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_server_scheduled_jobs.example acme/123/1001
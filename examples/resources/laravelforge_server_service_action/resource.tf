@@ -0,0 +1,18 @@
+resource "laravelforge_php_config" "fpm" {
+  server      = laravelforge_servers.app.server
+  php_version = laravelforge_php_versions.php83.php_version
+  kind        = "fpm"
+  content     = file("${path.module}/php-fpm.ini")
+}
+
+# Restart PHP-FPM whenever its configuration changes.
+resource "laravelforge_server_service_action" "restart_php" {
+  server  = laravelforge_servers.app.server
+  service = "php"
+  action  = "reboot"
+  version = "php83"
+
+  triggers = {
+    fpm = sha256(laravelforge_php_config.fpm.content)
+  }
+}
@@ -0,0 +1,18 @@
+resource "laravelforge_servers" "app" {
+  name           = "app-1"
+  provider_name  = "ocean2"
+  credential_id  = "12345"
+  type           = "app"
+  ubuntu_version = "24.04"
+  php_version    = "php84"
+
+  ocean2 = {
+    region_id = "ams3"
+    size_id   = "s-1vcpu-1gb"
+  }
+
+  # Create waits until the server is provisioned and connected.
+  timeouts {
+    create = "45m"
+  }
+}
@@ -0,0 +1,11 @@
+resource "laravelforge_site_deploy_hook" "app" {
+  server = laravelforge_servers.app.server
+  site   = laravelforge_sites.app.site
+}
+
+# Hand the URL to CI, for example as a GitHub Actions secret.
+resource "github_actions_secret" "forge_deploy_hook" {
+  repository      = "app"
+  secret_name     = "FORGE_DEPLOY_HOOK"
+  plaintext_value = laravelforge_site_deploy_hook.app.url
+}
@@ -0,0 +1,7 @@
+resource "laravelforge_site_deployment_script" "app" {
+  server           = laravelforge_servers.app.server
+  site             = laravelforge_sites.app.site
+  content          = file("${path.module}/deploy.sh")
+  auto_source      = true
+  deploy_on_change = true
+}
@@ -0,0 +1,3 @@
+# The import ID is organization/server/site/domain_record. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_site_domains.example acme/123/456/789
@@ -0,0 +1,7 @@
+resource "laravelforge_site_domains" "www" {
+  server                    = laravelforge_servers.app.server
+  site                      = laravelforge_sites.app.site
+  name                      = "www.example.com"
+  allow_wildcard_subdomains = false
+  www_redirect_type         = "none"
+}
@@ -0,0 +1,4 @@
+# The import ID is organization/server/site. The organization may be left out
+# when it is set on the provider. The imported resource manages the whole .env
+# file as content.
+terraform import laravelforge_site_environment.example acme/123/456
@@ -0,0 +1,19 @@
+# Manage a few variables and keep the rest of the .env file.
+resource "laravelforge_site_environment" "app" {
+  server = laravelforge_servers.app.server
+  site   = laravelforge_sites.app.site
+  merge  = true
+
+  variables = {
+    APP_ENV     = "production"
+    APP_DEBUG   = "false"
+    DB_PASSWORD = var.db_password
+  }
+}
+
+# Or manage the whole file.
+resource "laravelforge_site_environment" "worker" {
+  server  = laravelforge_servers.worker.server
+  site    = laravelforge_sites.worker.site
+  content = file("${path.module}/worker.env")
+}
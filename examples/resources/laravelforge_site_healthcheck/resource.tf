@@ -0,0 +1,5 @@
+resource "laravelforge_site_healthcheck" "app" {
+  server               = laravelforge_servers.app.server
+  site                 = laravelforge_sites.app.site
+  healthcheck_endpoint = "https://example.com/up"
+}
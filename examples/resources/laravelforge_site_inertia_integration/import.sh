@@ -0,0 +1,3 @@
+# The import ID is organization/server/site. The organization may be left out
+# when it is set on the provider.
+terraform import laravelforge_site_inertia_integration.example acme/123/456
@@ -0,0 +1,4 @@
+resource "laravelforge_site_inertia_integration" "app" {
+  server = laravelforge_servers.app.server
+  site   = laravelforge_sites.app.site
+}
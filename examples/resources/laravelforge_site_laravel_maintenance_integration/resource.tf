@@ -0,0 +1,6 @@
+resource "laravelforge_site_laravel_maintenance_integration" "app" {
+  server      = laravelforge_servers.app.server
+  site        = laravelforge_sites.app.site
+  status_code = 503
+  secret      = var.maintenance_secret
+}
@@ -0,0 +1,4 @@
+resource "laravelforge_site_laravel_scheduler_integration" "app" {
+  server = laravelforge_servers.app.server
+  site   = laravelforge_sites.app.site
+}
@@ -0,0 +1,4 @@
+# The import ID is organization/server/site. The organization may be left out
+# when it is set on the provider. The API does not return the balancer method,
+# so the first apply after importing sends the nodes again.
+terraform import laravelforge_site_load_balancing.example acme/123/456
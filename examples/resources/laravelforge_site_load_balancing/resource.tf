@@ -0,0 +1,12 @@
+resource "laravelforge_site_load_balancing" "app" {
+  server          = laravelforge_servers.balancer.server
+  site            = laravelforge_sites.balancer.site
+  balancer_method = "least_conn"
+
+  balancing = [
+    for server in laravelforge_servers.app : {
+      server_id = server.server
+      weight    = 1
+    }
+  ]
+}
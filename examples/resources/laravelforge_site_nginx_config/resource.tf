@@ -0,0 +1,5 @@
+resource "laravelforge_site_nginx_config" "app" {
+  server  = laravelforge_servers.app.server
+  site    = laravelforge_sites.app.site
+  content = templatefile("${path.module}/nginx.conf.tftpl", { domain = "example.com" })
+}
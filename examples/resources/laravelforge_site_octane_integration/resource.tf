@@ -0,0 +1,6 @@
+resource "laravelforge_site_octane_integration" "app" {
+  server        = laravelforge_servers.app.server
+  site          = laravelforge_sites.app.site
+  octane_server = "swoole"
+  port          = "8000"
+}
@@ -0,0 +1,4 @@
+resource "laravelforge_site_pulse_integration" "app" {
+  server = laravelforge_servers.app.server
+  site   = laravelforge_sites.app.site
+}
@@ -0,0 +1,5 @@
+resource "laravelforge_site_push_to_deploy" "app" {
+  server = laravelforge_servers.app.server
+  site   = laravelforge_sites.app.site
+  branch = "main"
+}
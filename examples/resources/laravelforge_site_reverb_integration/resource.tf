@@ -0,0 +1,7 @@
+resource "laravelforge_site_reverb_integration" "app" {
+  server      = laravelforge_servers.app.server
+  site        = laravelforge_sites.app.site
+  host        = "ws.example.com"
+  port        = "8080"
+  connections = 1000
+}
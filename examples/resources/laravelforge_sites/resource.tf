@@ -0,0 +1,9 @@
+resource "laravelforge_sites" "app" {
+  server = laravelforge_servers.app.server
+  type   = "laravel"
+  name   = "example.com"
+
+  source_control_provider = "github"
+  repository              = "acme/app"
+  branch                  = "main"
+}
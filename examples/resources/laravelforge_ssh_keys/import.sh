@@ -0,0 +1,3 @@
+# The import ID is organization/server/key_id. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_ssh_keys.example acme/123/1001
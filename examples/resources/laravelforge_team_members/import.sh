@@ -0,0 +1,3 @@
+# The import ID is organization/team/user. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_team_members.example acme/12/34
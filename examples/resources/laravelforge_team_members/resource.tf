@@ -0,0 +1,5 @@
+resource "laravelforge_team_members" "ann" {
+  team    = laravelforge_teams.developers.team
+  user    = 34
+  role_id = laravelforge_roles.developer.role
+}
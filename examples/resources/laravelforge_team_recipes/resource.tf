@@ -0,0 +1,4 @@
+resource "laravelforge_team_recipes" "hardening" {
+  team      = laravelforge_teams.developers.team
+  recipe_id = laravelforge_recipes.hardening.recipe
+}
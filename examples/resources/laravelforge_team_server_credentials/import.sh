@@ -0,0 +1,3 @@
+# The import ID is organization/team/credential_id. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_team_server_credentials.example acme/12/78
@@ -0,0 +1,4 @@
+resource "laravelforge_team_server_credentials" "aws" {
+  team          = laravelforge_teams.developers.team
+  credential_id = 78
+}
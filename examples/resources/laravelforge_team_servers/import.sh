@@ -0,0 +1,3 @@
+# The import ID is organization/team/server_id. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_team_servers.example acme/12/123
@@ -0,0 +1,6 @@
+resource "laravelforge_team_servers" "app" {
+  for_each = laravelforge_servers.app
+
+  team      = laravelforge_teams.developers.team
+  server_id = each.value.server
+}
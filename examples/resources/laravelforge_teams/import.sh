@@ -0,0 +1,3 @@
+# The import ID is organization/team. The organization may be
+# left out when it is set on the provider.
+terraform import laravelforge_teams.example acme/12
@@ -0,0 +1,436 @@
+// Command overlay applies generator/overlay.yml to the provider code spec
+// produced by tfplugingen-openapi, so that hand-maintained schema tweaks
+// survive regenerating the spec from the OpenAPI document.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Overlay describes the changes applied on top of the generated spec.
+type Overlay struct {
+	// Provider is merged into the provider definition of the spec. The
+	// generator cannot derive the provider schema from the OpenAPI document.
+	Provider yaml.Node `yaml:"provider"`
+
+	// Resources holds the changes per resource, keyed by resource name.
+	Resources map[string]ResourceOverlay `yaml:"resources"`
+
+	// DataSources holds the changes per data source, keyed by name.
+	DataSources map[string]ResourceOverlay `yaml:"data_sources"`
+}
+
+// ResourceOverlay describes the changes applied to a single resource or
+// data source.
+type ResourceOverlay struct {
+	// Renames maps top-level attributes to new names, for attributes whose
+	// name is reserved by Terraform.
+	Renames map[string]string `yaml:"renames"`
+
+	// Removes lists top-level attributes to drop, for query parameters the
+	// generator config cannot ignore.
+	Removes []string `yaml:"removes"`
+
+	// Attributes are added to the top level of the resource schema,
+	// replacing generated attributes with the same name.
+	Attributes []yaml.Node `yaml:"attributes"`
+
+	// Sensitive lists the attributes holding secrets, as dotted paths such
+	// as credentials.password for nested attributes.
+	Sensitive []string `yaml:"sensitive"`
+
+	// NotSensitive lists the attributes named like secrets, such as
+	// public_key, that are not secret. See checkSensitive.
+	NotSensitive []string `yaml:"not_sensitive"`
+
+	// Define adds the resource to the spec, with Attributes as its schema,
+	// for endpoints the generator cannot map, such as create operations
+	// without a request body.
+	Define bool `yaml:"define"`
+}
+
+func main() {
+	overlayPath := flag.String("overlay", "generator/overlay.yml", "path to the overlay file")
+	specPath := flag.String("spec", "provider_code_spec.json", "path to the provider code spec, rewritten in place")
+	flag.Parse()
+
+	if err := run(*overlayPath, *specPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(overlayPath, specPath string) error {
+	var overlay Overlay
+
+	raw, err := os.ReadFile(overlayPath)
+	if err != nil {
+		return err
+	}
+
+	if err := yaml.Unmarshal(raw, &overlay); err != nil {
+		return fmt.Errorf("parsing %s: %w", overlayPath, err)
+	}
+
+	raw, err = os.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := decodeJSON(bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", specPath, err)
+	}
+
+	spec, ok := decoded.(*object)
+	if !ok {
+		return fmt.Errorf("%s: expected a JSON object", specPath)
+	}
+
+	if err := apply(overlay, spec); err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(spec, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(specPath, out, 0o644)
+}
+
+func apply(overlay Overlay, spec *object) error {
+	if !overlay.Provider.IsZero() {
+		if err := applyProvider(&overlay.Provider, spec); err != nil {
+			return fmt.Errorf("provider: %w", err)
+		}
+	}
+
+	allowed := map[string]bool{}
+
+	for _, kind := range []struct {
+		key      string
+		prefix   string
+		overlays map[string]ResourceOverlay
+	}{
+		{key: "resources", overlays: overlay.Resources},
+		{key: "datasources", prefix: "data.", overlays: overlay.DataSources},
+	} {
+		definitions, err := specDefinitions(spec, kind.key)
+		if err != nil {
+			return err
+		}
+
+		for name, definition := range definitions {
+			if err := flattenData(definition); err != nil {
+				return fmt.Errorf("%s %s: %w", kind.key, name, err)
+			}
+		}
+
+		for name, o := range kind.overlays {
+			definition, ok := definitions[name]
+
+			switch {
+			case ok && o.Define:
+				return fmt.Errorf("%s %s: already in spec, remove define from the overlay", kind.key, name)
+			case o.Define:
+				definition = defineResource(spec, kind.key, name)
+			case !ok:
+				return fmt.Errorf("%s %s: not found in spec", kind.key, name)
+			}
+
+			if err := applyResource(o, definition); err != nil {
+				return fmt.Errorf("%s %s: %w", kind.key, name, err)
+			}
+
+			for _, p := range o.NotSensitive {
+				allowed[kind.prefix+name+"."+p] = true
+			}
+		}
+	}
+
+	return checkSensitive(spec, allowed)
+}
+
+// specDefinitions returns the resources or data sources of the spec, as
+// selected by key, keyed by name.
+func specDefinitions(spec *object, key string) (map[string]*object, error) {
+	v, _ := spec.Get(key)
+	if v == nil {
+		return map[string]*object{}, nil
+	}
+
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("spec %s must be a list", key)
+	}
+
+	definitions := make(map[string]*object, len(list))
+
+	for _, item := range list {
+		definition, ok := item.(*object)
+		if !ok {
+			return nil, fmt.Errorf("spec %s must be objects", key)
+		}
+
+		name, _ := definition.Get("name")
+		definitions[fmt.Sprint(name)] = definition
+	}
+
+	return definitions, nil
+}
+
+// defineResource adds a resource or data source without attributes to the
+// spec, keeping the definitions sorted by name like the generator does.
+func defineResource(spec *object, key, name string) *object {
+	schema := newObject()
+	schema.Set("attributes", []any{})
+
+	definition := newObject()
+	definition.Set("name", name)
+	definition.Set("schema", schema)
+
+	v, _ := spec.Get(key)
+	list, _ := v.([]any)
+
+	i := sort.Search(len(list), func(i int) bool {
+		other, _ := list[i].(*object)
+		if other == nil {
+			return false
+		}
+
+		n, _ := other.Get("name")
+
+		return fmt.Sprint(n) >= name
+	})
+
+	list = append(list[:i], append([]any{definition}, list[i:]...)...)
+	spec.Set(key, list)
+
+	return definition
+}
+
+// flattenData replaces the data attribute, which mirrors the JSON:API
+// document returned by the API, with the members of data.attributes at the
+// top level of the schema. Attributes already at the top level, such as the
+// ones of the request body, take precedence. The id, type, links and meta
+// members of the document are dropped.
+//
+// When data is a list, as for data sources reading a list endpoint, its
+// elements are flattened instead and keep the id of the resource.
+func flattenData(resource *object) error {
+	schema, attributes, err := resourceSchema(resource)
+	if err != nil {
+		return err
+	}
+
+	index := findAttribute(attributes, "data")
+	if index < 0 {
+		return nil
+	}
+
+	data, _ := attributes[index].(*object)
+
+	typ, definition := attributeType(data)
+	if definition == nil {
+		return fmt.Errorf("data has no type")
+	}
+
+	if typ == "list_nested" {
+		return flattenListData(definition)
+	}
+
+	members := dataAttributes(nestedAttributesOf(definition))
+	attributes = append(attributes[:index], attributes[index+1:]...)
+
+	for _, member := range members {
+		if a, ok := member.(*object); ok {
+			if name, _ := a.Get("name"); findAttribute(attributes, name) < 0 {
+				attributes = append(attributes, member)
+			}
+		}
+	}
+
+	schema.Set("attributes", attributes)
+
+	return nil
+}
+
+// flattenListData replaces the attributes of the elements of a data list
+// with their id and the members of their attributes.
+func flattenListData(definition *object) error {
+	v, _ := definition.Get("nested_object")
+
+	element, ok := v.(*object)
+	if !ok {
+		return fmt.Errorf("data has no nested object")
+	}
+
+	document := nestedAttributesOf(definition)
+	attributes := []any{}
+
+	if i := findAttribute(document, "id"); i >= 0 {
+		attributes = append(attributes, document[i])
+	}
+
+	for _, member := range dataAttributes(document) {
+		if a, ok := member.(*object); ok {
+			if name, _ := a.Get("name"); findAttribute(attributes, name) < 0 {
+				attributes = append(attributes, member)
+			}
+		}
+	}
+
+	element.Set("attributes", attributes)
+
+	return nil
+}
+
+// dataAttributes returns the members of the attributes object of a JSON:API
+// resource object.
+func dataAttributes(document []any) []any {
+	attribute := findPath(document, []string{"attributes"})
+	if attribute == nil {
+		return nil
+	}
+
+	_, definition := attributeType(attribute)
+	if definition == nil {
+		return nil
+	}
+
+	return nestedAttributesOf(definition)
+}
+
+// findAttribute returns the index of the attribute with the given name, or
+// -1 when there is none.
+func findAttribute(attributes []any, name any) int {
+	for i, attribute := range attributes {
+		if o, ok := attribute.(*object); ok {
+			if n, _ := o.Get("name"); n == name {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// resourceSchema returns the schema of a resource and its top-level
+// attributes.
+func resourceSchema(resource *object) (*object, []any, error) {
+	v, _ := resource.Get("schema")
+
+	schema, ok := v.(*object)
+	if !ok {
+		return nil, nil, fmt.Errorf("resource has no schema")
+	}
+
+	v, _ = schema.Get("attributes")
+	attributes, _ := v.([]any)
+
+	return schema, attributes, nil
+}
+
+func applyResource(overlay ResourceOverlay, resource *object) error {
+	schema, attributes, err := resourceSchema(resource)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range overlay.Removes {
+		i := findAttribute(attributes, name)
+		if i < 0 {
+			return fmt.Errorf("remove %s: attribute not found", name)
+		}
+
+		attributes = append(attributes[:i], attributes[i+1:]...)
+	}
+
+	for from, to := range overlay.Renames {
+		if !renameAttribute(attributes, from, to) {
+			return fmt.Errorf("rename %s: attribute not found", from)
+		}
+	}
+
+	for i := range overlay.Attributes {
+		value, err := decodeYAML(&overlay.Attributes[i])
+		if err != nil {
+			return err
+		}
+
+		attribute, ok := value.(*object)
+		if !ok {
+			return fmt.Errorf("line %d: attribute must be a mapping", overlay.Attributes[i].Line)
+		}
+
+		attributes = setAttribute(attributes, attribute)
+	}
+
+	if err := markSensitive(attributes, overlay.Sensitive); err != nil {
+		return err
+	}
+
+	schema.Set("attributes", attributes)
+
+	return nil
+}
+
+// setAttribute replaces the attribute with the same name in attributes, or
+// appends it when there is none.
+func setAttribute(attributes []any, attribute *object) []any {
+	name, _ := attribute.Get("name")
+
+	if i := findAttribute(attributes, name); i >= 0 {
+		attributes[i] = attribute
+
+		return attributes
+	}
+
+	return append(attributes, attribute)
+}
+
+// renameAttribute renames the attribute called from, and reports whether
+// it was found.
+func renameAttribute(attributes []any, from, to string) bool {
+	i := findAttribute(attributes, from)
+	if i < 0 {
+		return false
+	}
+
+	attributes[i].(*object).Set("name", to) //nolint:forcetypeassert // findAttribute only matches objects.
+
+	return true
+}
+
+func applyProvider(node *yaml.Node, spec *object) error {
+	value, err := decodeYAML(node)
+	if err != nil {
+		return err
+	}
+
+	fields, ok := value.(*object)
+	if !ok {
+		return fmt.Errorf("expected a mapping")
+	}
+
+	v, _ := spec.Get("provider")
+
+	provider, ok := v.(*object)
+	if !ok {
+		return fmt.Errorf("spec has no provider definition")
+	}
+
+	for _, k := range fields.keys {
+		provider.Set(k, fields.values[k])
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// object is a JSON object that remembers the order of its keys, so that
+// rewriting the spec does not reshuffle the output of the generator.
+type object struct {
+	keys   []string
+	values map[string]any
+}
+
+func newObject() *object {
+	return &object{values: map[string]any{}}
+}
+
+func (o *object) Get(key string) (any, bool) {
+	v, ok := o.values[key]
+
+	return v, ok
+}
+
+func (o *object) Set(key string, value any) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+
+	o.values[key] = value
+}
+
+func (o *object) Delete(key string) {
+	if _, ok := o.values[key]; !ok {
+		return
+	}
+
+	delete(o.values, key)
+
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(o.values[k])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// decodeJSON reads a JSON document, keeping objects as *object and numbers
+// as json.Number.
+func decodeJSON(r io.Reader) (any, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	return decodeJSONValue(decoder)
+}
+
+func decodeJSONValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		o := newObject()
+
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			key, ok := token.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object key %v", token)
+			}
+
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			o.Set(key, value)
+		}
+
+		_, err := decoder.Token()
+
+		return o, err
+	case json.Delim('['):
+		list := []any{}
+
+		for decoder.More() {
+			value, err := decodeJSONValue(decoder)
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		_, err := decoder.Token()
+
+		return list, err
+	default:
+		return token, nil
+	}
+}
+
+// decodeYAML converts a YAML node into the same representation as
+// decodeJSON, so overlay fragments can be spliced into the spec.
+func decodeYAML(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return decodeYAML(node.Content[0])
+	case yaml.MappingNode:
+		o := newObject()
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := decodeYAML(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+
+			o.Set(node.Content[i].Value, value)
+		}
+
+		return o, nil
+	case yaml.SequenceNode:
+		list := []any{}
+
+		for _, n := range node.Content {
+			value, err := decodeYAML(n)
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		return list, nil
+	case yaml.ScalarNode:
+		var value any
+
+		if err := node.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	case yaml.AliasNode:
+		return decodeYAML(node.Alias)
+	}
+
+	return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
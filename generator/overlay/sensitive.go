@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// secretName matches attribute names that usually hold secrets. Attributes
+// with such a name must be marked as sensitive, or listed as not_sensitive
+// in the overlay.
+var secretName = regexp.MustCompile(`(^|_)(password|key|token|secret)$`)
+
+// markSensitive marks the attributes at the given dotted paths as
+// sensitive.
+func markSensitive(attributes []any, paths []string) error {
+	for _, p := range paths {
+		attribute := findPath(attributes, strings.Split(p, "."))
+		if attribute == nil {
+			return fmt.Errorf("sensitive %s: attribute not found", p)
+		}
+
+		_, definition := attributeType(attribute)
+		if definition == nil {
+			return fmt.Errorf("sensitive %s: attribute has no type", p)
+		}
+
+		definition.Set("sensitive", true)
+	}
+
+	return nil
+}
+
+// checkSensitive returns an error listing the attributes of the spec named
+// like secrets that are not sensitive, except the ones in allowed, which
+// holds paths such as sites.public_deploy_key, or data.servers.servers.key
+// for data sources.
+func checkSensitive(spec *object, allowed map[string]bool) error {
+	var leaks []string
+
+	if v, _ := spec.Get("provider"); v != nil {
+		if provider, ok := v.(*object); ok {
+			leaks = append(leaks, sensitiveLeaks("provider", schemaAttributesOf(provider), allowed)...)
+		}
+	}
+
+	for _, kind := range []struct{ key, prefix string }{{"resources", ""}, {"datasources", "data."}} {
+		definitions, err := specDefinitions(spec, kind.key)
+		if err != nil {
+			return err
+		}
+
+		for name, definition := range definitions {
+			leaks = append(leaks, sensitiveLeaks(kind.prefix+name, schemaAttributesOf(definition), allowed)...)
+		}
+	}
+
+	if len(leaks) == 0 {
+		return nil
+	}
+
+	sort.Strings(leaks)
+
+	return fmt.Errorf("attributes named like secrets must be listed under sensitive or not_sensitive in the overlay:\n  %s", strings.Join(leaks, "\n  "))
+}
+
+func sensitiveLeaks(prefix string, attributes []any, allowed map[string]bool) []string {
+	var leaks []string
+
+	for _, a := range attributes {
+		attribute, ok := a.(*object)
+		if !ok {
+			continue
+		}
+
+		name, _ := attribute.Get("name")
+		p := fmt.Sprintf("%s.%v", prefix, name)
+		typ, definition := attributeType(attribute)
+
+		if definition == nil {
+			continue
+		}
+
+		if sensitive, _ := definition.Get("sensitive"); sensitive == true {
+			continue
+		}
+
+		if typ != "bool" && secretName.MatchString(fmt.Sprint(name)) && !allowed[p] {
+			leaks = append(leaks, p)
+		}
+
+		leaks = append(leaks, sensitiveLeaks(p, nestedAttributesOf(definition), allowed)...)
+	}
+
+	return leaks
+}
+
+// findPath returns the attribute at the path, descending into nested
+// attributes, or nil when there is none.
+func findPath(attributes []any, path []string) *object {
+	i := findAttribute(attributes, path[0])
+	if i < 0 {
+		return nil
+	}
+
+	attribute, _ := attributes[i].(*object)
+
+	if len(path) == 1 {
+		return attribute
+	}
+
+	_, definition := attributeType(attribute)
+	if definition == nil {
+		return nil
+	}
+
+	return findPath(nestedAttributesOf(definition), path[1:])
+}
+
+// attributeType returns the type of an attribute, such as string or
+// single_nested, and its definition.
+func attributeType(attribute *object) (string, *object) {
+	for _, k := range attribute.keys {
+		if k == "name" {
+			continue
+		}
+
+		if definition, ok := attribute.values[k].(*object); ok {
+			return k, definition
+		}
+	}
+
+	return "", nil
+}
+
+// nestedAttributesOf returns the attributes of a nested attribute
+// definition, which are held by nested_object for lists, sets and maps.
+func nestedAttributesOf(definition *object) []any {
+	if v, _ := definition.Get("nested_object"); v != nil {
+		if nested, ok := v.(*object); ok {
+			definition = nested
+		}
+	}
+
+	v, _ := definition.Get("attributes")
+	attributes, _ := v.([]any)
+
+	return attributes
+}
+
+// schemaAttributesOf returns the top-level attributes of a provider or
+// resource definition.
+func schemaAttributesOf(definition *object) []any {
+	v, _ := definition.Get("schema")
+
+	schema, ok := v.(*object)
+	if !ok {
+		return nil
+	}
+
+	return nestedAttributesOf(schema)
+}
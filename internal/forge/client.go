@@ -0,0 +1,352 @@
+// Package forge implements a small client for the Laravel Forge API as
+// described by generator/docs.openapi.json.
+package forge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultMaxRequestsPerMinute is the rate limit of the Laravel Forge API.
+const DefaultMaxRequestsPerMinute = 60
+
+const (
+	// maxRetries is the number of times a throttled or failed request is
+	// retried.
+	maxRetries = 5
+
+	// minBackoff and maxBackoff bound the wait before a retry when the
+	// response has no Retry-After header.
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+)
+
+// Config holds the settings used to build a Client.
+type Config struct {
+	// BaseURL is the API server, such as https://forge.laravel.com/api.
+	BaseURL string
+
+	// Token is the API token sent as a bearer token.
+	Token string
+
+	// Organization is the organization slug used when a path does not
+	// name one explicitly.
+	Organization string
+
+	// UserAgent is sent with every request.
+	UserAgent string
+
+	// MaxRequestsPerMinute limits the requests sent by the client,
+	// DefaultMaxRequestsPerMinute when zero.
+	MaxRequestsPerMinute int
+
+	// HTTPClient is used to send requests, http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// Client sends requests to the Laravel Forge API.
+type Client struct {
+	baseURL      *url.URL
+	token        string
+	organization string
+	userAgent    string
+	httpClient   *http.Client
+	limiter      *limiter
+}
+
+// NewClient returns a client for the given configuration.
+func NewClient(config Config) (*Client, error) {
+	baseURL, err := url.Parse(strings.TrimSuffix(config.BaseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing base URL: %w", err)
+	}
+
+	if baseURL.Scheme == "" || baseURL.Host == "" {
+		return nil, fmt.Errorf("base URL must be absolute, got %q", config.BaseURL)
+	}
+
+	if config.Token == "" {
+		return nil, fmt.Errorf("API token is required")
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	maxRequestsPerMinute := config.MaxRequestsPerMinute
+	if maxRequestsPerMinute == 0 {
+		maxRequestsPerMinute = DefaultMaxRequestsPerMinute
+	}
+
+	if maxRequestsPerMinute < 0 {
+		return nil, fmt.Errorf("max requests per minute must be positive, got %d", maxRequestsPerMinute)
+	}
+
+	return &Client{
+		baseURL:      baseURL,
+		token:        config.Token,
+		organization: config.Organization,
+		userAgent:    config.UserAgent,
+		httpClient:   httpClient,
+		limiter:      newLimiter(maxRequestsPerMinute),
+	}, nil
+}
+
+// Organization returns the default organization slug, which may be empty.
+func (c *Client) Organization() string {
+	return c.organization
+}
+
+var pathParameter = regexp.MustCompile(`{(\w+)}`)
+
+// ExpandPath fills in the parameters of an OpenAPI path template such as
+// /orgs/{organization}/servers/{server}. Values are escaped.
+func ExpandPath(template string, params map[string]string) (string, error) {
+	var missing []string
+
+	path := pathParameter.ReplaceAllStringFunc(template, func(match string) string {
+		name := match[1 : len(match)-1]
+
+		value, ok := params[name]
+		if !ok || value == "" {
+			missing = append(missing, name)
+
+			return match
+		}
+
+		return url.PathEscape(value)
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("%s: missing value for %s", template, strings.Join(missing, ", "))
+	}
+
+	return path, nil
+}
+
+// PathParameters returns the names of the parameters of a path template in
+// the order they appear.
+func PathParameters(template string) []string {
+	var names []string
+
+	for _, match := range pathParameter.FindAllStringSubmatch(template, -1) {
+		names = append(names, match[1])
+	}
+
+	return names
+}
+
+// Get sends a GET request and decodes the response into out.
+func (c *Client) Get(ctx context.Context, path string, query url.Values, out any) error {
+	return c.Do(ctx, http.MethodGet, path, query, nil, out)
+}
+
+// Post sends a POST request with body encoded as JSON and decodes the
+// response into out.
+func (c *Client) Post(ctx context.Context, path string, body, out any) error {
+	return c.Do(ctx, http.MethodPost, path, nil, body, out)
+}
+
+// Put sends a PUT request with body encoded as JSON and decodes the
+// response into out.
+func (c *Client) Put(ctx context.Context, path string, body, out any) error {
+	return c.Do(ctx, http.MethodPut, path, nil, body, out)
+}
+
+// Patch sends a PATCH request with body encoded as JSON and decodes the
+// response into out.
+func (c *Client) Patch(ctx context.Context, path string, body, out any) error {
+	return c.Do(ctx, http.MethodPatch, path, nil, body, out)
+}
+
+// Delete sends a DELETE request.
+func (c *Client) Delete(ctx context.Context, path string) error {
+	return c.Do(ctx, http.MethodDelete, path, nil, nil, nil)
+}
+
+// Do sends a request to the API. The path must already be escaped, see
+// ExpandPath. A nil body sends no request body, a nil out discards the response
+// body. Numbers in untyped values are decoded as json.Number. Responses
+// outside the 2xx range are returned as an *Error.
+//
+// Requests wait for the rate limit of the client. Throttled requests and
+// unavailable responses are retried with exponential backoff, honouring the
+// Retry-After header, see retryable.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	u, err := url.Parse(c.baseURL.String() + path)
+	if err != nil {
+		return fmt.Errorf("building URL for %s: %w", path, err)
+	}
+
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+
+	var encoded []byte
+
+	if body != nil {
+		if encoded, err = json.Marshal(body); err != nil {
+			return fmt.Errorf("encoding request body: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		waited, err := c.limiter.wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		if waited > 0 {
+			tflog.Debug(ctx, "Waited for the Laravel Forge API rate limit", map[string]any{
+				"method": method,
+				"path":   path,
+				"wait":   waited.String(),
+			})
+		}
+
+		resp, raw, err := c.send(ctx, method, u.String(), encoded)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+			return decode(method, path, raw, out)
+		}
+
+		if attempt >= maxRetries || !retryable(method, resp.StatusCode) {
+			return newError(method, path, resp.StatusCode, raw)
+		}
+
+		delay := retryDelay(resp.Header.Get("Retry-After"), attempt)
+
+		tflog.Debug(ctx, "Retrying Laravel Forge API request", map[string]any{
+			"method":  method,
+			"path":    path,
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    delay.String(),
+		})
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// send sends a single request and returns the response with its body.
+func (c *Client) send(ctx context.Context, method, u string, body []byte) (*http.Response, []byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading response of %s %s: %w", method, req.URL.Path, err)
+	}
+
+	return resp, raw, nil
+}
+
+func decode(method, path string, raw []byte, out any) error {
+	if out == nil || len(bytes.TrimSpace(raw)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("decoding response of %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
+
+// retryable reports whether a response with the given status is retried.
+// Throttled (429) and unavailable (503) requests were not handled by the
+// API. Gateway errors (502, 504) may have reached it, so they are only
+// retried for idempotent methods.
+func retryable(method string, status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return method == http.MethodGet || method == http.MethodPut || method == http.MethodDelete
+	}
+
+	return false
+}
+
+// retryDelay returns the wait before retrying, from the Retry-After header
+// when it is set or else from exponential backoff.
+func retryDelay(retryAfter string, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(retryAfter); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+
+		return 0
+	}
+
+	delay := minBackoff << attempt
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+
+	return delay
+}
+
+// Me returns the user the API token belongs to.
+func (c *Client) Me(ctx context.Context) (*Resource, error) {
+	var doc Document
+
+	if err := c.Get(ctx, "/me", nil, &doc); err != nil {
+		return nil, err
+	}
+
+	if doc.Data == nil {
+		return nil, fmt.Errorf("GET /me: response has no data")
+	}
+
+	return doc.Data, nil
+}
@@ -0,0 +1,127 @@
+package forge
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		want       time.Duration
+	}{
+		{name: "seconds", retryAfter: "5", want: 5 * time.Second},
+		{name: "zero seconds", retryAfter: "0", attempt: 3, want: 0},
+		{name: "date in the past", retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0},
+		{name: "backoff first attempt", want: minBackoff},
+		{name: "backoff doubles", attempt: 2, want: 4 * minBackoff},
+		{name: "backoff is capped", attempt: 10, want: maxBackoff},
+		{name: "negative seconds use backoff", retryAfter: "-1", attempt: 1, want: 2 * minBackoff},
+		{name: "invalid header uses backoff", retryAfter: "soon", want: minBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(tt.retryAfter, tt.attempt); got != tt.want {
+				t.Errorf("retryDelay(%q, %d) = %s, want %s", tt.retryAfter, tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelayDateInTheFuture(t *testing.T) {
+	at := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+
+	if got := retryDelay(at, 0); got <= 8*time.Second || got > 10*time.Second {
+		t.Errorf("retryDelay(%q, 0) = %s, want about 10s", at, got)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{http.MethodPost, http.StatusTooManyRequests, true},
+		{http.MethodPost, http.StatusServiceUnavailable, true},
+		{http.MethodGet, http.StatusBadGateway, true},
+		{http.MethodPut, http.StatusGatewayTimeout, true},
+		{http.MethodDelete, http.StatusBadGateway, true},
+		{http.MethodPost, http.StatusBadGateway, false},
+		{http.MethodPatch, http.StatusGatewayTimeout, false},
+		{http.MethodGet, http.StatusInternalServerError, false},
+		{http.MethodGet, http.StatusNotFound, false},
+	}
+
+	for _, tt := range tests {
+		if got := retryable(tt.method, tt.status); got != tt.want {
+			t.Errorf("retryable(%s, %d) = %t, want %t", tt.method, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestDoRetriesThrottledRequests(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":{"id":"1","type":"servers"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{BaseURL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc Document
+
+	if err := client.Get(context.Background(), "/servers/1", nil, &doc); err != nil {
+		t.Fatalf("got error %v, want the request to be retried", err)
+	}
+
+	if requests != 3 {
+		t.Errorf("got %d requests, want 3", requests)
+	}
+
+	if doc.Data == nil || doc.Data.ID != "1" {
+		t.Errorf("got %+v, want server 1", doc.Data)
+	}
+}
+
+func TestDoDoesNotRetryPostOnGatewayErrors(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(Config{BaseURL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Post(context.Background(), "/servers", map[string]any{}, nil); err == nil {
+		t.Fatal("got no error, want the gateway error")
+	}
+
+	if requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
@@ -0,0 +1,89 @@
+package forge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Error is returned for API responses outside the 2xx range.
+type Error struct {
+	Method     string
+	Path       string
+	StatusCode int
+
+	// Message is the error overview returned by the API.
+	Message string
+
+	// Errors holds the validation errors per field of a 422 response.
+	Errors map[string][]string
+}
+
+func newError(method, path string, statusCode int, body []byte) *Error {
+	e := &Error{
+		Method:     method,
+		Path:       path,
+		StatusCode: statusCode,
+	}
+
+	var payload struct {
+		Message string              `json:"message"`
+		Errors  map[string][]string `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &payload); err == nil {
+		e.Message = payload.Message
+		e.Errors = payload.Errors
+	}
+
+	if e.Message == "" {
+		e.Message = http.StatusText(statusCode)
+	}
+
+	return e
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		fmt.Fprintf(&b, "\n  %s: %s", field, strings.Join(e.Errors[field], " "))
+	}
+
+	return b.String()
+}
+
+// IsNotFound reports whether err is a 404 response from the API.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is a 401 response from the API,
+// returned when the API token is invalid or revoked.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is a 403 response from the API, returned
+// when the API token lacks the permission for an endpoint.
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *Error
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
@@ -0,0 +1,37 @@
+package forge
+
+// Document is a JSON:API document holding a single resource.
+type Document struct {
+	Data *Resource `json:"data"`
+}
+
+// Resource is a JSON:API resource object.
+type Resource struct {
+	ID            string                  `json:"id"`
+	Type          string                  `json:"type"`
+	Attributes    map[string]any          `json:"attributes"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+}
+
+// Relationship is a JSON:API relationship object. Data holds a resource
+// identifier or a list of them.
+type Relationship struct {
+	Data any `json:"data"`
+}
+
+// ListDocument is a JSON:API document holding a page of resources.
+type ListDocument struct {
+	Data  []Resource `json:"data"`
+	Links ListLinks  `json:"links"`
+	Meta  ListMeta   `json:"meta"`
+}
+
+// ListLinks holds the pagination links of a ListDocument.
+type ListLinks struct {
+	Next string `json:"next"`
+}
+
+// ListMeta holds the pagination metadata of a ListDocument.
+type ListMeta struct {
+	NextCursor string `json:"next_cursor"`
+}
@@ -0,0 +1,143 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the page size requested when listing resources. The
+// OpenAPI document lists 30 as the default of page[size] and documents no
+// maximum, so the iterator requests the default and follows the cursor.
+const DefaultPageSize = 30
+
+// Iterator walks the resources of a list endpoint, fetching the next page
+// when the current one is exhausted. Use it as:
+//
+//	it := client.List(path, nil)
+//	for it.Next(ctx) {
+//		resource := it.Resource()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	client *Client
+	path   string
+	query  url.Values
+
+	page  []Resource
+	index int
+	last  bool
+	err   error
+}
+
+// List returns an iterator over the resources of the list endpoint at
+// path, such as /orgs/{organization}/servers. The query may hold filters,
+// page[size] defaults to DefaultPageSize.
+func (c *Client) List(path string, query url.Values) *Iterator {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+
+	if q.Get("page[size]") == "" {
+		q.Set("page[size]", strconv.Itoa(DefaultPageSize))
+	}
+
+	return &Iterator{client: c, path: path, query: q, index: -1}
+}
+
+// Next advances to the next resource and reports whether there is one. It
+// returns false when all pages are read, on errors and when ctx is done.
+func (it *Iterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		it.err = err
+
+		return false
+	}
+
+	it.index++
+
+	for it.index >= len(it.page) {
+		if it.last {
+			return false
+		}
+
+		if err := it.fetch(ctx); err != nil {
+			it.err = err
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// Resource returns the current resource.
+func (it *Iterator) Resource() *Resource {
+	if it.index < 0 || it.index >= len(it.page) {
+		return nil
+	}
+
+	return &it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// fetch reads the next page and prepares the query of the page after it,
+// from meta.next_cursor or else from links.next.
+func (it *Iterator) fetch(ctx context.Context) error {
+	var doc ListDocument
+
+	if err := it.client.Get(ctx, it.path, it.query, &doc); err != nil {
+		return err
+	}
+
+	it.page = doc.Data
+	it.index = 0
+
+	switch {
+	case doc.Meta.NextCursor != "":
+		if doc.Meta.NextCursor == it.query.Get("page[cursor]") {
+			return fmt.Errorf("next cursor of %s does not advance: %s", it.path, doc.Meta.NextCursor)
+		}
+
+		it.query.Set("page[cursor]", doc.Meta.NextCursor)
+	case doc.Links.Next != "":
+		next, err := url.Parse(doc.Links.Next)
+		if err != nil {
+			return fmt.Errorf("parsing next page link of %s: %w", it.path, err)
+		}
+
+		if next.Query().Get("page[cursor]") == it.query.Get("page[cursor]") {
+			return fmt.Errorf("next page link of %s does not advance the cursor: %s", it.path, doc.Links.Next)
+		}
+
+		it.query = next.Query()
+	default:
+		it.last = true
+	}
+
+	return nil
+}
+
+// ListAll returns all resources of the list endpoint at path, see List.
+func (c *Client) ListAll(ctx context.Context, path string, query url.Values) ([]Resource, error) {
+	var resources []Resource
+
+	it := c.List(path, query)
+	for it.Next(ctx) {
+		resources = append(resources, *it.Resource())
+	}
+
+	return resources, it.Err()
+}
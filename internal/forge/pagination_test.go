@@ -0,0 +1,189 @@
+package forge
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// listServer serves pages of resources, keyed by the page[cursor] query
+// parameter, and records the queries it receives.
+func listServer(t *testing.T, pages map[string]string) (*Client, *[]string) {
+	t.Helper()
+
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		page, ok := pages[r.URL.Query().Get("page[cursor]")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		fmt.Fprint(w, page)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(Config{BaseURL: server.URL, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, &queries
+}
+
+func ids(resources []Resource) string {
+	var ids []string
+	for _, resource := range resources {
+		ids = append(ids, resource.ID)
+	}
+
+	return strings.Join(ids, ",")
+}
+
+func TestListAll(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages map[string]string
+		want  string
+	}{
+		{
+			name:  "single page",
+			pages: map[string]string{"": `{"data":[{"id":"1"},{"id":"2"}]}`},
+			want:  "1,2",
+		},
+		{
+			name:  "empty",
+			pages: map[string]string{"": `{"data":[]}`},
+			want:  "",
+		},
+		{
+			name: "next cursor",
+			pages: map[string]string{
+				"":  `{"data":[{"id":"1"}],"meta":{"next_cursor":"a"}}`,
+				"a": `{"data":[{"id":"2"}],"meta":{"next_cursor":"b"}}`,
+				"b": `{"data":[{"id":"3"}]}`,
+			},
+			want: "1,2,3",
+		},
+		{
+			name: "next link",
+			pages: map[string]string{
+				"":  `{"data":[{"id":"1"}],"links":{"next":"https://forge.test/api/servers?page%5Bcursor%5D=a&page%5Bsize%5D=30"}}`,
+				"a": `{"data":[{"id":"2"}]}`,
+			},
+			want: "1,2",
+		},
+		{
+			name: "empty page before the last one",
+			pages: map[string]string{
+				"":  `{"data":[],"meta":{"next_cursor":"a"}}`,
+				"a": `{"data":[{"id":"1"}]}`,
+			},
+			want: "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := listServer(t, tt.pages)
+
+			resources, err := client.ListAll(context.Background(), "/servers", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := ids(resources); got != tt.want {
+				t.Errorf("got resources %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListSendsPageSizeAndFilters(t *testing.T) {
+	client, queries := listServer(t, map[string]string{
+		"":  `{"data":[{"id":"1"}],"meta":{"next_cursor":"a"}}`,
+		"a": `{"data":[]}`,
+	})
+
+	query := map[string][]string{"filter[region]": {"ams3"}}
+
+	if _, err := client.ListAll(context.Background(), "/servers", query); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"filter%5Bregion%5D=ams3&page%5Bsize%5D=30",
+		"filter%5Bregion%5D=ams3&page%5Bcursor%5D=a&page%5Bsize%5D=30",
+	}
+
+	if strings.Join(*queries, " ") != strings.Join(want, " ") {
+		t.Errorf("got queries %q, want %q", *queries, want)
+	}
+
+	if len(query) != 1 {
+		t.Errorf("got query %v, want the query of the caller unchanged", query)
+	}
+}
+
+func TestListStopsWhenCursorDoesNotAdvance(t *testing.T) {
+	client, _ := listServer(t, map[string]string{
+		"":  `{"data":[{"id":"1"}],"meta":{"next_cursor":"a"}}`,
+		"a": `{"data":[{"id":"2"}],"meta":{"next_cursor":"a"}}`,
+	})
+
+	if _, err := client.ListAll(context.Background(), "/servers", nil); err == nil || !strings.Contains(err.Error(), "does not advance") {
+		t.Errorf("got error %v, want the cursor not advancing", err)
+	}
+}
+
+func TestIteratorReportsErrors(t *testing.T) {
+	client, _ := listServer(t, map[string]string{
+		"": `{"data":[{"id":"1"}],"meta":{"next_cursor":"missing"}}`,
+	})
+
+	it := client.List("/servers", nil)
+
+	if !it.Next(context.Background()) || it.Resource().ID != "1" {
+		t.Fatal("want the first resource")
+	}
+
+	if it.Next(context.Background()) {
+		t.Fatal("got a resource, want the iteration to stop")
+	}
+
+	if !IsNotFound(it.Err()) {
+		t.Errorf("got error %v, want not found", it.Err())
+	}
+
+	if it.Resource() != nil {
+		t.Errorf("got resource %+v after the iteration stopped, want none", it.Resource())
+	}
+}
+
+func TestIteratorStopsWhenContextIsDone(t *testing.T) {
+	client, queries := listServer(t, map[string]string{"": `{"data":[{"id":"1"}]}`})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := client.List("/servers", nil)
+
+	if it.Next(ctx) {
+		t.Fatal("got a resource, want the iteration to stop")
+	}
+
+	if it.Err() != context.Canceled {
+		t.Errorf("got error %v, want %v", it.Err(), context.Canceled)
+	}
+
+	if len(*queries) != 0 {
+		t.Errorf("got %d requests, want none", len(*queries))
+	}
+}
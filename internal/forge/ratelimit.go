@@ -0,0 +1,73 @@
+package forge
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket shared by all requests of a client. It holds up
+// to burst tokens and gains one every interval.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+}
+
+// newLimiter returns a limiter allowing perMinute requests per minute, with
+// bursts of up to the same number of requests.
+func newLimiter(perMinute int) *limiter {
+	return &limiter{
+		interval: time.Minute / time.Duration(perMinute),
+		burst:    float64(perMinute),
+		tokens:   float64(perMinute),
+		last:     time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it.
+func (l *limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// wait blocks until a request may be sent and returns how long it waited.
+func (l *limiter) wait(ctx context.Context) (time.Duration, error) {
+	delay := l.reserve()
+	if delay == 0 {
+		return 0, nil
+	}
+
+	return delay, sleep(ctx, delay)
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+package forge
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLimiterAllowsBurst(t *testing.T) {
+	l := newLimiter(60)
+
+	for i := 0; i < 60; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("request %d: got wait %s, want none within the burst", i+1, d)
+		}
+	}
+
+	if d := l.reserve(); d < 900*time.Millisecond || d > time.Second {
+		t.Errorf("request 61: got wait %s, want about 1s", d)
+	}
+
+	if d := l.reserve(); d < 1900*time.Millisecond || d > 2*time.Second {
+		t.Errorf("request 62: got wait %s, want about 2s", d)
+	}
+}
+
+func TestLimiterRefills(t *testing.T) {
+	l := newLimiter(60)
+	l.tokens = 0
+	l.last = time.Now().Add(-2 * time.Second)
+
+	if d := l.reserve(); d != 0 {
+		t.Errorf("got wait %s, want none after two intervals", d)
+	}
+
+	if d := l.reserve(); d != 0 {
+		t.Errorf("got wait %s, want none for the second refilled token", d)
+	}
+
+	if d := l.reserve(); d == 0 {
+		t.Error("got no wait, want one once the refilled tokens are used")
+	}
+}
+
+func TestLimiterCapsTokensAtBurst(t *testing.T) {
+	l := newLimiter(2)
+	l.last = time.Now().Add(-time.Hour)
+
+	l.reserve()
+	l.reserve()
+
+	if d := l.reserve(); d == 0 {
+		t.Error("got no wait, want tokens capped at the burst")
+	}
+}
+
+func TestLimiterWaitStopsWhenContextIsDone(t *testing.T) {
+	l := newLimiter(1)
+	l.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := l.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// actionResource implements resources that run an action when they are
+// created, such as restarting a service. They have no update operation, so
+// changing the action or its triggers replaces the resource and runs the
+// action again. Destroying them does nothing.
+type actionResource struct {
+	*apiResource
+}
+
+func (r *actionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.apiResource.Schema(ctx, req, resp)
+
+	resp.Schema.Description = "Creating this resource runs the action, and changing it or its triggers runs the action again. Destroying it only removes it from the Terraform state."
+	resp.Schema.MarkdownDescription = resp.Schema.Description
+}
+
+// Delete does nothing, an action cannot be undone.
+func (r *actionResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
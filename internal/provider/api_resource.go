@@ -0,0 +1,985 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+var (
+	_ resource.Resource               = &apiResource{}
+	_ resource.ResourceWithConfigure  = &apiResource{}
+	_ resource.ResourceWithModifyPlan = &apiResource{}
+)
+
+// pollInterval is the time between requests while waiting for a resource to
+// become ready. Tests shorten it.
+var pollInterval = 10 * time.Second
+
+// operation is an endpoint of the Laravel Forge API.
+type operation struct {
+	method string
+	path   string
+}
+
+// apiResource implements a resource on top of the schema generated from the
+// OpenAPI document. The path parameters of the operations map onto
+// attributes of the same name in snake case:
+//
+//   - organization defaults to the organization of the provider,
+//   - parameters of the create path are the parents of the resource, such as
+//     server and site, and are required,
+//   - the remaining parameters of the read path identify the resource and
+//     are set from the id of the created resource.
+//
+// Configured attributes are sent as the request body, computed attributes
+// are read from the attributes of the JSON:API resource in the response,
+// which generator/overlay moves to the top level of the schema.
+type apiResource struct {
+	// name is the resource type name without the provider prefix.
+	name   string
+	schema func(context.Context) schema.Schema
+
+	create operation
+	read   operation
+
+	// update is nil when the API cannot update the resource in place, in
+	// which case every change replaces it.
+	update *operation
+
+	// delete is nil when the API cannot delete the resource, in which case
+	// destroying it only removes it from the state.
+	delete *operation
+
+	// aliases maps path parameters to attribute names where the generator
+	// renamed the attribute to avoid a conflict with the request body.
+	aliases map[string]string
+
+	// jsonAPIType is set for operations that expect a JSON:API document as
+	// the request body, in which case the attributes are sent as a resource
+	// object of this type.
+	jsonAPIType string
+
+	// fields maps attributes to the request and response fields of the
+	// API where the attribute was renamed, see generator/overlay.yml.
+	fields map[string]string
+
+	// relationships maps attributes to the relationships of the JSON:API
+	// resource whose id they hold, such as the role of a team member.
+	relationships map[string]string
+
+	// bodyFields maps attributes to the request body fields of the API where
+	// they differ from the response fields, such as nginx configurations,
+	// which are sent as config and read as content.
+	bodyFields map[string]string
+
+	// updateFields maps attributes to the request body fields of the update
+	// operation. Changes to other attributes replace the resource. When nil,
+	// all attributes are sent as named in the create operation.
+	updateFields map[string]string
+
+	// local lists attributes that only configure the provider, which are
+	// not sent to the API.
+	local []string
+
+	// equal maps string attributes to functions reporting whether a value
+	// read from the API is equivalent to the one in the state, such as
+	// scripts that only differ in line endings. The value in the state is
+	// kept when they are. For computed attributes, it is also kept when the
+	// configuration changes to an equivalent value, Terraform does not
+	// allow planning another value than the configured one otherwise.
+	equal map[string]func(state, read string) bool
+
+	// ready is set for resources the API creates asynchronously. Create
+	// polls the resource until ready reports it can be used, for at most
+	// createTimeout unless the timeouts block of the resource says otherwise.
+	ready         readyFunc
+	createTimeout time.Duration
+
+	// exists is set for resources the API keeps returning after they are
+	// gone, such as disabled site integrations. Read removes the resource
+	// from the state when exists reports false for its JSON:API attributes.
+	exists func(attributes map[string]any) bool
+
+	typeName string
+	client   *forge.Client
+}
+
+// readyFunc reports whether the resource with the given JSON:API attributes
+// is ready to use. The status describes the progress of the resource for
+// logs and errors. An error stops waiting for the resource.
+type readyFunc func(attributes map[string]any) (status string, ready bool, err error)
+
+func (r *apiResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.typeName = req.ProviderTypeName + "_" + r.name
+	resp.TypeName = r.typeName
+}
+
+func (r *apiResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	s := r.schema(ctx)
+
+	plainNestedObjects(s.Attributes)
+
+	for _, name := range r.parents() {
+		attribute, ok := s.Attributes[name]
+		if !ok {
+			continue
+		}
+
+		s.Attributes[name] = requiredAttribute(attribute)
+	}
+
+	for _, name := range r.ids() {
+		attribute, ok := s.Attributes[name]
+		if !ok || attribute.IsRequired() {
+			continue
+		}
+
+		s.Attributes[name] = computedAttribute(attribute)
+	}
+
+	if r.delete == nil {
+		s.Description = "Destroying this resource leaves it unchanged in Laravel Forge and only removes it from the Terraform state."
+		s.MarkdownDescription = s.Description
+	}
+
+	if r.ready != nil {
+		if s.Blocks == nil {
+			s.Blocks = map[string]schema.Block{}
+		}
+
+		s.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{Create: true})
+	}
+
+	resp.Schema = s
+}
+
+func (r *apiResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// The framework calls Metadata on another instance, so the type name
+	// used in diagnostics is set here.
+	r.typeName = providerTypeName + "_" + r.name
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forge.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *forge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *apiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	config, err := objectValues(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	s := r.schema(ctx)
+
+	if organization, ok := plan["organization"]; ok && !organization.IsKnown() {
+		switch {
+		case isSet(state["organization"]):
+			plan["organization"] = state["organization"]
+		case r.client != nil && r.client.Organization() != "":
+			plan["organization"] = tftypes.NewValue(tftypes.String, r.client.Organization())
+		case r.client != nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("organization"),
+				"Missing organization",
+				"Set the organization attribute on the resource, or the organization of the provider.",
+			)
+		}
+	}
+
+	if len(state) > 0 {
+		for _, name := range r.ids() {
+			if attribute, ok := s.Attributes[name]; ok && !attribute.IsRequired() {
+				plan[name] = state[name]
+			}
+		}
+
+		for name, attribute := range s.Attributes {
+			if attribute.IsComputed() && attribute.IsOptional() && config[name].IsNull() {
+				plan[name] = state[name]
+			}
+		}
+
+		for name, equal := range r.equal {
+			attribute, ok := s.Attributes[name]
+			if !ok || !attribute.IsComputed() || !isSet(plan[name]) || !isSet(state[name]) {
+				continue
+			}
+
+			var planned, prior string
+			if plan[name].As(&planned) == nil && state[name].As(&prior) == nil && equal(prior, planned) {
+				plan[name] = state[name]
+			}
+		}
+
+		for name, v := range plan {
+			if prior, ok := state[name]; ok && !v.Equal(prior) && r.requiresReplace(name, s.Attributes[name]) {
+				resp.RequiresReplace = append(resp.RequiresReplace, path.Root(name))
+			}
+		}
+	}
+
+	resp.Plan.Raw = tftypes.NewValue(req.Plan.Raw.Type(), plan)
+}
+
+// requiresReplace reports whether a change to the attribute replaces the
+// resource.
+func (r *apiResource) requiresReplace(name string, attribute schema.Attribute) bool {
+	if name == "organization" || contains(r.parents(), name) {
+		return true
+	}
+
+	if attribute == nil || !(attribute.IsRequired() || attribute.IsOptional()) {
+		return false
+	}
+
+	if r.update == nil {
+		return true
+	}
+
+	if r.updateFields == nil {
+		return false
+	}
+
+	_, ok := r.updateFields[name]
+
+	return !ok
+}
+
+func (r *apiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	body, err := r.requestBody(plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build request", err.Error())
+
+		return
+	}
+
+	data, ok := r.do(ctx, r.create, plan, body, "create", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if data == nil {
+		// Resources without an id of their own, such as the certificate of
+		// a domain, can be read back with the parameters of the plan.
+		if data, ok = r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics); !ok {
+			return
+		}
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+
+	if r.ready == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createTimeouts timeouts.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &createTimeouts)...)
+
+	timeout, diags := createTimeouts.Create(ctx, r.createTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource exists from here on, so it stays in the state when
+	// waiting fails and Terraform marks it as tainted.
+	values, err := objectValues(resp.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	data, err = r.waitReady(ctx, values, data, r.ready, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Error waiting for "+r.typeName+" to become ready", err.Error())
+
+		return
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+}
+
+// notReadyError is returned by waitReady when the resource is not ready
+// before the timeout.
+type notReadyError struct {
+	path    string
+	timeout time.Duration
+	status  string
+}
+
+func (e *notReadyError) Error() string {
+	return fmt.Sprintf("%s was not ready after %s, last status: %s", e.path, e.timeout, e.status)
+}
+
+// waitReady polls the resource until ready reports it is ready and returns
+// its last data.
+func (r *apiResource) waitReady(ctx context.Context, values map[string]tftypes.Value, data map[string]any, ready readyFunc, timeout time.Duration) (map[string]any, error) {
+	path, err := r.path(r.read, values)
+	if err != nil {
+		return data, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		attributes, _ := data["attributes"].(map[string]any)
+
+		status, done, err := ready(attributes)
+		if err != nil {
+			return data, err
+		}
+
+		if done {
+			return data, nil
+		}
+
+		tflog.Debug(ctx, "Waiting for "+r.typeName+" to become ready", map[string]any{"path": path, "status": status})
+
+		select {
+		case <-ctx.Done():
+			return data, &notReadyError{path: path, timeout: timeout, status: status}
+		case <-time.After(pollInterval):
+		}
+
+		var doc struct {
+			Data map[string]any `json:"data"`
+		}
+
+		if err := r.client.Get(ctx, path, nil, &doc); err != nil {
+			if forge.IsNotFound(err) {
+				return data, fmt.Errorf("%s was deleted before it became ready, last status: %s", path, status)
+			}
+
+			if ctx.Err() != nil {
+				return data, &notReadyError{path: path, timeout: timeout, status: status}
+			}
+
+			return data, err
+		}
+
+		if doc.Data != nil {
+			data = doc.Data
+		}
+	}
+}
+
+func (r *apiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	path, err := r.path(r.read, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	var doc struct {
+		Data map[string]any `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, path, nil, &doc); err != nil {
+		if forge.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	if attributes, _ := doc.Data["attributes"].(map[string]any); r.exists != nil && !r.exists(attributes) {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	r.setState(req.State.Raw, doc.Data, true, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *apiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	var data map[string]any
+
+	if r.update != nil {
+		body, err := r.requestBody(plan, r.updateFields)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to build request", err.Error())
+
+			return
+		}
+
+		var ok bool
+		if data, ok = r.do(ctx, *r.update, plan, body, "update", &resp.Diagnostics); !ok {
+			return
+		}
+	}
+
+	if data == nil {
+		var ok bool
+		if data, ok = r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics); !ok {
+			return
+		}
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *apiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.delete == nil {
+		resp.Diagnostics.AddWarning(
+			"Resource removed from state only",
+			"The Laravel Forge API cannot delete "+r.typeName+" resources. It was removed from the Terraform state but still exists in Laravel Forge.",
+		)
+
+		return
+	}
+
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	path, err := r.path(*r.delete, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete "+r.typeName, err.Error())
+
+		return
+	}
+
+	err = r.client.Do(ctx, r.delete.method, path, nil, nil, nil)
+	if err != nil && !forge.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to delete "+r.typeName, err.Error())
+	}
+}
+
+// ImportState sets the attributes of the path parameters from an import ID
+// of the form organization/server/site/domain_record, see importFormat. The
+// organization may be left out when the provider sets one.
+func (r *apiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	names := r.importNames()
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) == len(names)-1 && r.client != nil && r.client.Organization() != "" {
+		parts = append([]string{r.client.Organization()}, parts...)
+	}
+
+	if len(parts) != len(names) {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID of the form %s, got: %q", r.importFormat(), req.ID),
+		)
+
+		return
+	}
+
+	attributes := r.schema(ctx).Attributes
+
+	for i, name := range names {
+		if parts[i] == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				fmt.Sprintf("The %s part of the import ID is empty, expected the form %s.", name, r.importFormat()),
+			)
+
+			return
+		}
+
+		var value any = parts[i]
+
+		if _, ok := attributes[name].(schema.Int64Attribute); ok {
+			n, err := strconv.ParseInt(parts[i], 10, 64)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Invalid import ID",
+					fmt.Sprintf("The %s part of the import ID must be a number, got: %q", name, parts[i]),
+				)
+
+				return
+			}
+
+			value = n
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importNames returns the attributes set from the parts of an import ID, in
+// order: the organization, the parents and the ids of the resource.
+func (r *apiResource) importNames() []string {
+	return append(append([]string{"organization"}, r.parents()...), r.ids()...)
+}
+
+// importFormat returns the form of the import ID, such as
+// organization/server/site.
+func (r *apiResource) importFormat() string {
+	return strings.Join(r.importNames(), "/")
+}
+
+// do sends a request for the operation and returns the data of the
+// response, which is nil when the response has none.
+func (r *apiResource) do(ctx context.Context, op operation, values map[string]tftypes.Value, body map[string]any, action string, diags *diag.Diagnostics) (map[string]any, bool) {
+	path, err := r.path(op, values)
+	if err != nil {
+		diags.AddError("Unable to "+action+" "+r.typeName, err.Error())
+
+		return nil, false
+	}
+
+	var doc struct {
+		Data map[string]any `json:"data"`
+	}
+
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+
+	if err := r.client.Do(ctx, op.method, path, nil, reqBody, &doc); err != nil {
+		diags.AddError("Unable to "+action+" "+r.typeName, err.Error())
+
+		return nil, false
+	}
+
+	return doc.Data, true
+}
+
+// path expands the path of the operation with the parameter values found in
+// values.
+func (r *apiResource) path(op operation, values map[string]tftypes.Value) (string, error) {
+	params := map[string]string{}
+
+	for _, param := range forge.PathParameters(op.path) {
+		v, ok := values[r.attributeName(param)]
+		if !ok || !v.IsKnown() || v.IsNull() {
+			if param == "organization" && r.client.Organization() != "" {
+				params[param] = r.client.Organization()
+			}
+
+			continue
+		}
+
+		value, err := paramValue(v)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", param, err)
+		}
+
+		params[param] = value
+	}
+
+	return forge.ExpandPath(op.path, params)
+}
+
+// requestBody returns the configured attributes to send to the API. When
+// fields is not nil, only the attributes it names are sent, renamed to the
+// field name.
+func (r *apiResource) requestBody(values map[string]tftypes.Value, fields map[string]string) (map[string]any, error) {
+	skip := map[string]bool{"organization": true, "timeouts": true}
+	for _, name := range append(r.parents(), r.local...) {
+		skip[name] = true
+	}
+
+	body := map[string]any{}
+
+	for name, v := range values {
+		if skip[name] {
+			continue
+		}
+
+		field := r.field(name)
+		if bodyField, ok := r.bodyFields[name]; ok {
+			field = bodyField
+		}
+
+		if fields != nil {
+			var ok bool
+			if field, ok = fields[name]; !ok {
+				continue
+			}
+		}
+
+		value, err := toJSON(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if value != nil {
+			body[field] = value
+		}
+	}
+
+	if r.jsonAPIType != "" {
+		body = map[string]any{
+			"data": map[string]any{
+				"type":       r.jsonAPIType,
+				"attributes": body,
+			},
+		}
+	}
+
+	return body, nil
+}
+
+// setState stores the resource in data in the state. Configured values are
+// kept from prior, which is the plan or the prior state. When refresh is
+// set they are updated from the API where it returns them.
+func (r *apiResource) setState(prior tftypes.Value, data map[string]any, refresh bool, state *tftypes.Value, diags *diag.Diagnostics) {
+	if data == nil {
+		diags.AddError("Unexpected Laravel Forge API response", "The response of the "+r.typeName+" resource has no data.")
+
+		return
+	}
+
+	objectType, ok := prior.Type().(tftypes.Object)
+	if !ok {
+		diags.AddError("Unexpected state type", fmt.Sprintf("Expected an object, got %s.", prior.Type()))
+
+		return
+	}
+
+	values, err := objectValues(prior)
+	if err != nil {
+		diags.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	ids := r.ids()
+
+	for name, typ := range objectType.AttributeTypes {
+		v := values[name]
+		read := r.responseValue(data, name)
+
+		switch {
+		case name == "organization" || name == "timeouts" || contains(r.parents(), name):
+		case contains(ids, name) && !isSet(v):
+			v, err = fromJSON(typ, data["id"])
+		case isSet(v) && v.IsFullyKnown():
+			if refresh && read != nil && !r.equivalent(name, v, read) {
+				if refreshed, err := fromJSON(typ, read); err == nil {
+					v = refreshed
+				}
+			}
+		default:
+			v, err = fromJSON(typ, read)
+		}
+
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Unexpected Laravel Forge API response", err.Error())
+
+			return
+		}
+
+		values[name] = nullUnknowns(v)
+	}
+
+	*state = tftypes.NewValue(objectType, values)
+}
+
+// responseValue returns the value of the attribute in the data of a
+// response, which is the id of a relationship or an attribute of the JSON:API
+// resource.
+func (r *apiResource) responseValue(data map[string]any, name string) any {
+	if relationship, ok := r.relationships[name]; ok {
+		relationships, _ := data["relationships"].(map[string]any)
+		related, _ := relationships[relationship].(map[string]any)
+		identifier, _ := related["data"].(map[string]any)
+
+		return identifier["id"]
+	}
+
+	attributes, _ := data["attributes"].(map[string]any)
+
+	return attributes[r.field(name)]
+}
+
+// equivalent reports whether the value read from the API is equivalent to
+// the value v of the attribute, see apiResource.equal.
+func (r *apiResource) equivalent(name string, v tftypes.Value, read any) bool {
+	equal, ok := r.equal[name]
+	if !ok {
+		return false
+	}
+
+	s, isString := read.(string)
+
+	var current string
+	if !isString || v.As(&current) != nil {
+		return false
+	}
+
+	return equal(current, s)
+}
+
+// parents returns the attribute names of the parameters of the create path,
+// other than organization.
+func (r *apiResource) parents() []string {
+	var names []string
+
+	for _, param := range forge.PathParameters(r.create.path) {
+		if param != "organization" {
+			names = append(names, r.attributeName(param))
+		}
+	}
+
+	return names
+}
+
+// ids returns the attribute names of the parameters that identify the
+// resource within its parents. They are usually those of the read path, but
+// resources the API only lists are identified in their delete path.
+func (r *apiResource) ids() []string {
+	create := forge.PathParameters(r.create.path)
+	params := forge.PathParameters(r.read.path)
+
+	if r.delete != nil {
+		params = append(params, forge.PathParameters(r.delete.path)...)
+	}
+
+	var names []string
+
+	for _, param := range params {
+		if name := r.attributeName(param); !contains(create, param) && !contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// field returns the name of the API field of the attribute.
+func (r *apiResource) field(name string) string {
+	if field, ok := r.fields[name]; ok {
+		return field
+	}
+
+	return name
+}
+
+func (r *apiResource) attributeName(param string) string {
+	if name, ok := r.aliases[param]; ok {
+		return name
+	}
+
+	return snakeCase(param)
+}
+
+// plainNestedObjects drops the custom types generated for the objects of
+// nested attributes. The resources work on the Terraform values directly,
+// and the framework fails to plan lists and sets of custom object types.
+func plainNestedObjects(attributes map[string]schema.Attribute) {
+	for name, attribute := range attributes {
+		switch a := attribute.(type) {
+		case schema.ListNestedAttribute:
+			a.NestedObject.CustomType = nil
+			plainNestedObjects(a.NestedObject.Attributes)
+			attributes[name] = a
+		case schema.SetNestedAttribute:
+			a.NestedObject.CustomType = nil
+			plainNestedObjects(a.NestedObject.Attributes)
+			attributes[name] = a
+		case schema.MapNestedAttribute:
+			a.NestedObject.CustomType = nil
+			plainNestedObjects(a.NestedObject.Attributes)
+			attributes[name] = a
+		case schema.SingleNestedAttribute:
+			plainNestedObjects(a.Attributes)
+		}
+	}
+}
+
+// requiredAttribute returns the attribute marked as required.
+func requiredAttribute(attribute schema.Attribute) schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.Int64Attribute:
+		a.Required, a.Optional, a.Computed = true, false, false
+
+		return a
+	case schema.StringAttribute:
+		a.Required, a.Optional, a.Computed = true, false, false
+
+		return a
+	}
+
+	return attribute
+}
+
+// computedAttribute returns the attribute marked as computed only.
+func computedAttribute(attribute schema.Attribute) schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.Int64Attribute:
+		a.Required, a.Optional, a.Computed = false, false, true
+
+		return a
+	case schema.StringAttribute:
+		a.Required, a.Optional, a.Computed = false, false, true
+
+		return a
+	}
+
+	return attribute
+}
+
+// paramValue formats a value for use as a path parameter.
+func paramValue(v tftypes.Value) (string, error) {
+	value, err := toJSON(v)
+	if err != nil {
+		return "", err
+	}
+
+	switch x := value.(type) {
+	case string:
+		return x, nil
+	case int64:
+		return strconv.FormatInt(x, 10), nil
+	}
+
+	return "", fmt.Errorf("unsupported path parameter type %s", v.Type())
+}
+
+// nullUnknowns replaces unknown values, including nested ones, with null.
+func nullUnknowns(v tftypes.Value) tftypes.Value {
+	if v.IsFullyKnown() {
+		return v
+	}
+
+	v, _ = tftypes.Transform(v, func(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.IsKnown() {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+
+		return v, nil
+	})
+
+	return v
+}
+
+func isSet(v tftypes.Value) bool {
+	return v.IsKnown() && !v.IsNull()
+}
+
+// integrationEnabled reports whether a site integration is enabled. The API
+// returns integrations whether they are enabled or not, with an enabled
+// attribute that is a boolean for some and a string for others. Integrations
+// that are still being enabled and values it does not understand count as
+// enabled, so they never drop the resource.
+func integrationEnabled(attributes map[string]any) bool {
+	if attributes["status"] == "enabling" {
+		return true
+	}
+
+	switch enabled := attributes["enabled"].(type) {
+	case bool:
+		return enabled
+	case string:
+		if enabled == "" {
+			return false
+		}
+
+		if b, err := strconv.ParseBool(enabled); err == nil {
+			return b
+		}
+	}
+
+	return true
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+
+	for i, c := range s {
+		if unicode.IsUpper(c) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+
+			c = unicode.ToLower(c)
+		}
+
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+func TestImportNames(t *testing.T) {
+	tests := []struct {
+		resource resource.Resource
+		want     []string
+	}{
+		{NewServersResource(), []string{"organization", "server"}},
+		{NewSiteDomainsResource(), []string{"organization", "server", "site", "domain_record"}},
+		{NewDomainCertificatesResource(), []string{"organization", "server", "site", "domain_record"}},
+		{NewPhpMaxUploadSizeResource(), []string{"organization", "server"}},
+	}
+
+	for _, tt := range tests {
+		r := tt.resource.(interface{ importNames() []string })
+
+		if got := r.importNames(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%T importNames() = %q, want %q", tt.resource, got, tt.want)
+		}
+	}
+}
+
+func TestImportState(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		organization string
+
+		// want are the imported attributes, or wantErr the start of the
+		// error detail.
+		want    map[string]tftypes.Value
+		wantErr string
+	}{
+		{
+			name: "explicit organization",
+			id:   "acme/1/2/3",
+			want: map[string]tftypes.Value{
+				"organization":  tftypes.NewValue(tftypes.String, "acme"),
+				"server":        tftypes.NewValue(tftypes.Number, 1),
+				"site":          tftypes.NewValue(tftypes.Number, 2),
+				"domain_record": tftypes.NewValue(tftypes.Number, 3),
+			},
+		},
+		{
+			name:         "explicit organization overrides the provider",
+			id:           "acme/1/2/3",
+			organization: "other",
+			want: map[string]tftypes.Value{
+				"organization":  tftypes.NewValue(tftypes.String, "acme"),
+				"server":        tftypes.NewValue(tftypes.Number, 1),
+				"site":          tftypes.NewValue(tftypes.Number, 2),
+				"domain_record": tftypes.NewValue(tftypes.Number, 3),
+			},
+		},
+		{
+			name:         "organization of the provider",
+			id:           "1/2/3",
+			organization: "acme",
+			want: map[string]tftypes.Value{
+				"organization":  tftypes.NewValue(tftypes.String, "acme"),
+				"server":        tftypes.NewValue(tftypes.Number, 1),
+				"site":          tftypes.NewValue(tftypes.Number, 2),
+				"domain_record": tftypes.NewValue(tftypes.Number, 3),
+			},
+		},
+		{
+			name:    "organization omitted without a provider organization",
+			id:      "1/2/3",
+			wantErr: "Expected an import ID of the form organization/server/site/domain_record",
+		},
+		{
+			name:    "too many parts",
+			id:      "acme/1/2/3/4",
+			wantErr: "Expected an import ID of the form organization/server/site/domain_record",
+		},
+		{
+			name:         "too few parts",
+			id:           "1/2",
+			organization: "acme",
+			wantErr:      "Expected an import ID of the form organization/server/site/domain_record",
+		},
+		{
+			name:    "empty",
+			id:      "",
+			wantErr: "Expected an import ID of the form organization/server/site/domain_record",
+		},
+		{
+			name:    "empty part",
+			id:      "acme/1//3",
+			wantErr: "The site part of the import ID is empty",
+		},
+		{
+			name:    "empty organization",
+			id:      "/1/2/3",
+			wantErr: "The organization part of the import ID is empty",
+		},
+		{
+			name:    "non-numeric part",
+			id:      "acme/1/example.com/3",
+			wantErr: `The site part of the import ID must be a number, got: "example.com"`,
+		},
+		{
+			name:    "non-integer part",
+			id:      "acme/1/2/3.5",
+			wantErr: `The domain_record part of the import ID must be a number, got: "3.5"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewSiteDomainsResource().(*apiResource)
+
+			if tt.organization != "" {
+				client, err := forge.NewClient(forge.Config{BaseURL: "https://forge.test/api", Token: "token", Organization: tt.organization})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				r.client = client
+			}
+
+			var schemaResp resource.SchemaResponse
+
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background())
+
+			resp := resource.ImportStateResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+			}
+
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: tt.id}, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.HasPrefix(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("ImportState(%q) diagnostics = %v, want an error starting with %q", tt.id, resp.Diagnostics, tt.wantErr)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ImportState(%q) diagnostics = %v", tt.id, resp.Diagnostics)
+			}
+
+			values, err := objectValues(resp.State.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for name, v := range values {
+				want, ok := tt.want[name]
+				if !ok {
+					want = tftypes.NewValue(v.Type(), nil)
+				}
+
+				if !v.Equal(want) {
+					t.Errorf("ImportState(%q) %s = %s, want %s", tt.id, name, v, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitReady(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	tests := []struct {
+		name string
+
+		// statuses are the connection statuses the fake API returns, the last
+		// one repeating. A missing status makes it respond with a 404.
+		statuses []string
+		timeout  time.Duration
+
+		wantRequests int
+		wantErr      string
+	}{
+		{
+			name:         "ready after polling",
+			statuses:     []string{"connecting", "connecting", "connected"},
+			timeout:      time.Minute,
+			wantRequests: 3,
+		},
+		{
+			name:     "timeout",
+			statuses: []string{"connecting"},
+			timeout:  20 * time.Millisecond,
+			wantErr:  `/orgs/acme/servers/1 was not ready after 20ms, last status: is_ready=true, connection_status="connecting"`,
+		},
+		{
+			name:         "failed",
+			statuses:     []string{"connecting", "failed"},
+			timeout:      time.Minute,
+			wantRequests: 2,
+			wantErr:      `provisioning failed, last status: is_ready=true, connection_status="failed"`,
+		},
+		{
+			name:         "deleted",
+			statuses:     []string{"connecting", ""},
+			timeout:      time.Minute,
+			wantRequests: 2,
+			wantErr:      `/orgs/acme/servers/1 was deleted before it became ready, last status: is_ready=true, connection_status="connecting"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/orgs/acme/servers/1" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				status := tt.statuses[len(tt.statuses)-1]
+				if requests < len(tt.statuses) {
+					status = tt.statuses[requests]
+				}
+
+				requests++
+
+				if status == "" {
+					http.NotFound(w, r)
+
+					return
+				}
+
+				fmt.Fprintf(w, `{"data":{"id":"1","type":"servers","attributes":{"is_ready":true,"connection_status":%q}}}`, status)
+			}))
+			defer server.Close()
+
+			client, err := forge.NewClient(forge.Config{BaseURL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := NewServersResource().(*apiResource)
+			r.client = client
+
+			values := map[string]tftypes.Value{
+				"organization": tftypes.NewValue(tftypes.String, "acme"),
+				"server":       tftypes.NewValue(tftypes.Number, 1),
+			}
+
+			data, err := r.waitReady(context.Background(), values, nil, serverReady, tt.timeout)
+
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("waitReady() error = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("waitReady() error = %v", err)
+			} else if attributes, _ := data["attributes"].(map[string]any); attributes["connection_status"] != "connected" {
+				t.Errorf("waitReady() returned %v, want the data of the connected server", data)
+			}
+
+			if tt.wantRequests != 0 && requests != tt.wantRequests {
+				t.Errorf("waitReady() sent %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestIntegrationEnabled(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		want       bool
+	}{
+		{"enabled", map[string]any{"enabled": true}, true},
+		{"disabled", map[string]any{"enabled": false}, false},
+		{"enabled string", map[string]any{"enabled": "true"}, true},
+		{"enabled number string", map[string]any{"enabled": "1"}, true},
+		{"disabled string", map[string]any{"enabled": "false"}, false},
+		{"disabled number string", map[string]any{"enabled": "0"}, false},
+		{"empty string", map[string]any{"enabled": ""}, false},
+		{"being enabled", map[string]any{"enabled": false, "status": "enabling"}, true},
+		{"being disabled", map[string]any{"enabled": false, "status": "disabling"}, false},
+		{"unknown string", map[string]any{"enabled": "yes please"}, true},
+		{"missing", map[string]any{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := integrationEnabled(tt.attributes); got != tt.want {
+				t.Errorf("integrationEnabled(%v) = %t, want %t", tt.attributes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadDisabledIntegration(t *testing.T) {
+	tests := []struct {
+		name       string
+		enabled    string
+		wantRemove bool
+	}{
+		{"enabled", "true", false},
+		{"disabled", "false", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/orgs/acme/servers/1/sites/2/integrations/horizon" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				fmt.Fprintf(w, `{"data":{"id":"2","type":"horizonIntegrations","attributes":{"enabled":%q,"horizon_installed":true}}}`, tt.enabled)
+			}))
+			defer server.Close()
+
+			client, err := forge.NewClient(forge.Config{BaseURL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := NewSiteHorizonIntegrationResource().(*apiResource)
+			r.client = client
+
+			var schemaResp resource.SchemaResponse
+
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"organization":      tftypes.NewValue(tftypes.String, "acme"),
+					"server":            tftypes.NewValue(tftypes.Number, 1),
+					"site":              tftypes.NewValue(tftypes.Number, 2),
+					"enabled":           tftypes.NewValue(tftypes.String, "true"),
+					"horizon_installed": tftypes.NewValue(tftypes.Bool, true),
+				}),
+			}
+
+			resp := resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+
+			if removed := resp.State.Raw.IsNull(); removed != tt.wantRemove {
+				t.Errorf("Read() removed the resource = %t, want %t", removed, tt.wantRemove)
+			}
+		})
+	}
+}
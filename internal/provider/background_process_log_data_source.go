@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/datasource_background_process_log"
+)
+
+var _ datasource.DataSource = &backgroundProcessLogDataSource{}
+
+// backgroundProcessLogDataSource reads the log of a background process,
+// optionally only its last lines. The log is a single resource rather than a
+// list, so only Read differs from listDataSource.
+type backgroundProcessLogDataSource struct {
+	*listDataSource
+}
+
+// NewBackgroundProcessLogDataSource returns the
+// laravelforge_background_process_log data source, which reads the log of a
+// background process.
+func NewBackgroundProcessLogDataSource() datasource.DataSource {
+	return &backgroundProcessLogDataSource{&listDataSource{
+		name:   "background_process_log",
+		schema: datasource_background_process_log.BackgroundProcessLogDataSourceSchema,
+		path:   "/orgs/{organization}/servers/{server}/background-processes/{backgroundProcess}/log",
+	}}
+}
+
+func (d *backgroundProcessLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	values, err := objectValues(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	readPath, ok := d.requestPath(values, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	var doc struct {
+		Data *forge.Resource `json:"data"`
+	}
+
+	if err := d.client.Get(ctx, readPath, nil, &doc); err != nil {
+		resp.Diagnostics.AddError("Unable to read "+d.typeName, err.Error())
+
+		return
+	}
+
+	var content string
+	if doc.Data != nil {
+		content, _ = doc.Data.Attributes["content"].(string)
+	}
+
+	if isSet(values["lines"]) {
+		var lines big.Float
+		_ = values["lines"].As(&lines)
+
+		n, _ := lines.Int64()
+		content = tailLines(content, int(n))
+	}
+
+	values["content"] = tftypes.NewValue(tftypes.String, content)
+
+	resp.State.Raw = tftypes.NewValue(req.Config.Raw.Type(), values)
+}
+
+// tailLines returns the last n lines of s. A trailing newline does not count
+// as a line.
+func tailLines(s string, n int) string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) <= n {
+		return s
+	}
+
+	return strings.Join(lines[len(lines)-n:], "")
+}
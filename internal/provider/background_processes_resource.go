@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_background_processes"
+)
+
+// supervisorSettings maps the attributes written to the supervisor
+// configuration of a background process to their key in it, in the order
+// Laravel Forge writes them.
+var supervisorSettings = []struct{ attribute, key string }{
+	{"directory", "directory"},
+	{"command", "command"},
+	{"user", "user"},
+	{"processes", "numprocs"},
+	{"startsecs", "startsecs"},
+	{"stopwaitsecs", "stopwaitsecs"},
+	{"stopsignal", "stopsignal"},
+}
+
+// supervisorTimeout is how long an update waits for Laravel Forge to install
+// the new supervisor configuration.
+const supervisorTimeout = 5 * time.Minute
+
+// backgroundProcessesResource manages a background process. The API only
+// updates the name of a process and its supervisor configuration as a
+// whole, and does not return the configuration, so changing any other
+// setting writes a configuration built from the plan and restarts the
+// process, which would otherwise keep running the old one.
+type backgroundProcessesResource struct {
+	*apiResource
+}
+
+// NewBackgroundProcessesResource returns the
+// laravelforge_background_processes resource, which manages a supervisor
+// managed background process on a server.
+func NewBackgroundProcessesResource() resource.Resource {
+	return &backgroundProcessesResource{&apiResource{
+		name:   "background_processes",
+		schema: resource_background_processes.BackgroundProcessesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/background-processes"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/background-processes/{backgroundProcess}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/background-processes/{backgroundProcess}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/background-processes/{backgroundProcess}"},
+
+		// Only name is sent as is, the other attributes are written to
+		// the supervisor configuration by Update.
+		updateFields: map[string]string{
+			"name":         "name",
+			"command":      "command",
+			"user":         "user",
+			"directory":    "directory",
+			"processes":    "processes",
+			"startsecs":    "startsecs",
+			"stopwaitsecs": "stopwaitsecs",
+			"stopsignal":   "stopsignal",
+		},
+	}}
+}
+
+func (r *backgroundProcessesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	body, err := r.requestBody(plan, map[string]string{"name": "name"})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build request", err.Error())
+
+		return
+	}
+
+	var changed bool
+
+	for _, setting := range supervisorSettings {
+		changed = changed || !plan[setting.attribute].Equal(state[setting.attribute])
+	}
+
+	if changed {
+		if body["config"], err = supervisorConfig(plan); err != nil {
+			resp.Diagnostics.AddError("Unable to build request", err.Error())
+
+			return
+		}
+	}
+
+	if _, ok := r.do(ctx, *r.update, plan, body, "update", &resp.Diagnostics); !ok {
+		return
+	}
+
+	if changed {
+		// The configuration is installed asynchronously, restarting the
+		// process before it is would keep the old command running.
+		if _, err := r.waitReady(ctx, plan, nil, backgroundProcessInstalled, supervisorTimeout); err != nil {
+			resp.Diagnostics.AddError("Error waiting for "+r.typeName+" to install its configuration", err.Error())
+
+			return
+		}
+
+		action := operation{method: http.MethodPost, path: r.update.path + "/actions"}
+		if _, ok := r.do(ctx, action, plan, map[string]any{"action": "restart"}, "restart", &resp.Diagnostics); !ok {
+			return
+		}
+	}
+
+	data, ok := r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+}
+
+// backgroundProcessInstalled reports whether a background process is
+// installed, which it is again once an update of its configuration is.
+func backgroundProcessInstalled(attributes map[string]any) (string, bool, error) {
+	status, _ := attributes["status"].(string)
+	description := fmt.Sprintf("status=%q", status)
+
+	if status == "removing" {
+		return description, false, errors.New("the background process is being removed")
+	}
+
+	return description, status == "installed", nil
+}
+
+// supervisorConfig returns the supervisor configuration of the background
+// process with the given attribute values, as Laravel Forge writes it when
+// creating the process. Settings that are not set are left to supervisor.
+func supervisorConfig(values map[string]tftypes.Value) (string, error) {
+	id, err := paramValue(values["background_process"])
+	if err != nil {
+		return "", fmt.Errorf("background_process: %w", err)
+	}
+
+	program := "daemon-" + id
+
+	lines := []string{
+		"[program:" + program + "]",
+		"process_name=%(program_name)s_%(process_num)02d",
+		"autostart=true",
+		"autorestart=true",
+	}
+
+	for _, setting := range supervisorSettings {
+		if !isSet(values[setting.attribute]) {
+			continue
+		}
+
+		value, err := paramValue(values[setting.attribute])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", setting.attribute, err)
+		}
+
+		lines = append(lines, setting.key+"="+value)
+	}
+
+	lines = append(lines,
+		"stdout_logfile=/home/forge/.forge/"+program+".log",
+		"stdout_logfile_maxbytes=5MB",
+		"stdout_logfile_backups=3",
+		"redirect_stderr=true",
+	)
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
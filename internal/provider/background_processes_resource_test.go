@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+// testBackgroundProcess returns the attribute values of background process 5
+// running php artisan queue:work, with the given values replaced.
+func testBackgroundProcess(objectType tftypes.Object, replace map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+
+	values["organization"] = tftypes.NewValue(tftypes.String, "acme")
+	values["server"] = tftypes.NewValue(tftypes.Number, 1)
+	values["background_process"] = tftypes.NewValue(tftypes.Number, 5)
+	values["name"] = tftypes.NewValue(tftypes.String, "Queue")
+	values["command"] = tftypes.NewValue(tftypes.String, "php artisan queue:work")
+	values["user"] = tftypes.NewValue(tftypes.String, "forge")
+	values["directory"] = tftypes.NewValue(tftypes.String, "/home/forge/app")
+	values["processes"] = tftypes.NewValue(tftypes.Number, 1)
+	values["status"] = tftypes.NewValue(tftypes.String, "installed")
+	values["created_at"] = tftypes.NewValue(tftypes.String, "2025-07-29T09:00:00Z")
+
+	for name, v := range replace {
+		values[name] = v
+	}
+
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestSupervisorConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]tftypes.Value
+		want   string
+	}{
+		{
+			name: "required settings",
+			values: map[string]tftypes.Value{
+				"background_process": tftypes.NewValue(tftypes.Number, 5),
+				"command":            tftypes.NewValue(tftypes.String, "php artisan queue:work --queue=high,default"),
+				"user":               tftypes.NewValue(tftypes.String, "forge"),
+				"directory":          tftypes.NewValue(tftypes.String, nil),
+				"processes":          tftypes.NewValue(tftypes.Number, 2),
+				"startsecs":          tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"stopwaitsecs":       tftypes.NewValue(tftypes.Number, nil),
+				"stopsignal":         tftypes.NewValue(tftypes.String, nil),
+			},
+			want: "[program:daemon-5]\nprocess_name=%(program_name)s_%(process_num)02d\nautostart=true\nautorestart=true\ncommand=php artisan queue:work --queue=high,default\nuser=forge\nnumprocs=2\nstdout_logfile=/home/forge/.forge/daemon-5.log\nstdout_logfile_maxbytes=5MB\nstdout_logfile_backups=3\nredirect_stderr=true\n",
+		},
+		{
+			name: "all settings",
+			values: map[string]tftypes.Value{
+				"background_process": tftypes.NewValue(tftypes.Number, 5),
+				"command":            tftypes.NewValue(tftypes.String, "php artisan horizon"),
+				"user":               tftypes.NewValue(tftypes.String, "root"),
+				"directory":          tftypes.NewValue(tftypes.String, "/home/forge/app"),
+				"processes":          tftypes.NewValue(tftypes.Number, 1),
+				"startsecs":          tftypes.NewValue(tftypes.Number, 1),
+				"stopwaitsecs":       tftypes.NewValue(tftypes.Number, 3600),
+				"stopsignal":         tftypes.NewValue(tftypes.String, "SIGTERM"),
+			},
+			want: "[program:daemon-5]\nprocess_name=%(program_name)s_%(process_num)02d\nautostart=true\nautorestart=true\ndirectory=/home/forge/app\ncommand=php artisan horizon\nuser=root\nnumprocs=1\nstartsecs=1\nstopwaitsecs=3600\nstopsignal=SIGTERM\nstdout_logfile=/home/forge/.forge/daemon-5.log\nstdout_logfile_maxbytes=5MB\nstdout_logfile_backups=3\nredirect_stderr=true\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := supervisorConfig(tt.values)
+			if err != nil {
+				t.Fatalf("supervisorConfig() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("supervisorConfig() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackgroundProcessesUpdate(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	tests := []struct {
+		name string
+		plan map[string]tftypes.Value
+
+		// wantConfig is the supervisor configuration sent, if any, in which
+		// case the process is restarted.
+		wantConfig string
+	}{
+		{
+			name: "name",
+			plan: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "Default queue")},
+		},
+		{
+			name: "command and processes",
+			plan: map[string]tftypes.Value{
+				"command":   tftypes.NewValue(tftypes.String, "php artisan horizon"),
+				"processes": tftypes.NewValue(tftypes.Number, 3),
+			},
+			wantConfig: "[program:daemon-5]\nprocess_name=%(program_name)s_%(process_num)02d\nautostart=true\nautorestart=true\ndirectory=/home/forge/app\ncommand=php artisan horizon\nuser=forge\nnumprocs=3\nstdout_logfile=/home/forge/.forge/daemon-5.log\nstdout_logfile_maxbytes=5MB\nstdout_logfile_backups=3\nredirect_stderr=true\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				body      map[string]any
+				restarted bool
+				reads     int
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				const path = "/orgs/acme/servers/1/background-processes/5"
+
+				switch {
+				case r.Method == http.MethodPut && r.URL.Path == path:
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Error(err)
+					}
+
+					fmt.Fprint(w, `{"data":{"id":"5","type":"backgroundProcesses","attributes":{"status":"installing"}}}`)
+				case r.Method == http.MethodPost && r.URL.Path == path+"/actions":
+					if reads == 0 {
+						t.Error("restarted the process before its configuration was installed")
+					}
+
+					restarted = true
+
+					w.WriteHeader(http.StatusAccepted)
+				case r.Method == http.MethodGet && r.URL.Path == path:
+					status := "installing"
+					if reads > 0 {
+						status = "installed"
+					}
+
+					reads++
+
+					fmt.Fprintf(w, `{"data":{"id":"5","type":"backgroundProcesses","attributes":{"command":"php artisan horizon","user":"forge","directory":"/home/forge/app","processes":3,"status":%q,"created_at":"2025-07-29T09:00:00Z"}}}`, status)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+			}))
+			defer server.Close()
+
+			client, err := forge.NewClient(forge.Config{BaseURL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := NewBackgroundProcessesResource().(*backgroundProcessesResource)
+			r.client = client
+
+			var schemaResp resource.SchemaResponse
+
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: testBackgroundProcess(objectType, nil)}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: testBackgroundProcess(objectType, tt.plan)}
+			resp := resource.UpdateResponse{State: state}
+
+			r.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update() diagnostics = %v", resp.Diagnostics)
+			}
+
+			config, _ := body["config"].(string)
+			if config != tt.wantConfig {
+				t.Errorf("Update() sent config %q, want %q", config, tt.wantConfig)
+			}
+
+			if name := body["name"]; name == nil {
+				t.Error("Update() did not send the name")
+			}
+
+			if want := tt.wantConfig != ""; restarted != want {
+				t.Errorf("Update() restarted the process = %t, want %t", restarted, want)
+			}
+
+			values, err := objectValues(resp.State.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for name, want := range tt.plan {
+				if !values[name].Equal(want) {
+					t.Errorf("Update() state %s = %s, want %s", name, values[name], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_composer_credentials"
+)
+
+// NewComposerCredentialsResource returns the
+// laravelforge_composer_credentials resource, which manages Composer
+// credentials for a package repository of a site.
+func NewComposerCredentialsResource() resource.Resource {
+	return &apiResource{
+		name:   "composer_credentials",
+		schema: resource_composer_credentials.ComposerCredentialsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/composer/credentials"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/composer/credentials/{repository}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/composer/credentials/{repository}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/composer/credentials/{repository}"},
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_database_schemas"
+)
+
+// NewDatabaseSchemasResource returns the laravelforge_database_schemas
+// resource, which manages a database on a server.
+func NewDatabaseSchemasResource() resource.Resource {
+	return &apiResource{
+		name:   "database_schemas",
+		schema: resource_database_schemas.DatabaseSchemasResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/database/schemas"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/database/schemas/{database}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/database/schemas/{database}"},
+	}
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_database_users"
+)
+
+// NewDatabaseUsersResource returns the laravelforge_database_users resource,
+// which manages a database user on a server.
+func NewDatabaseUsersResource() resource.Resource {
+	return &apiResource{
+		name:   "database_users",
+		schema: resource_database_users.DatabaseUsersResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/database/users"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/database/users/{databaseUser}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/database/users/{databaseUser}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/database/users/{databaseUser}"},
+
+		updateFields: map[string]string{
+			"password":     "password",
+			"database_ids": "database_ids",
+		},
+	}
+}
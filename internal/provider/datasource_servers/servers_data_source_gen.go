@@ -0,0 +1,1825 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package datasource_servers
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func ServersDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"database_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The database type of the server.",
+				MarkdownDescription: "The database type of the server.",
+			},
+			"ip_address": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The IP address of the server.",
+				MarkdownDescription: "The IP address of the server.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the server.",
+				MarkdownDescription: "The name of the server.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug. Defaults to the organization of the provider.",
+				MarkdownDescription: "The organization slug. Defaults to the organization of the provider.",
+			},
+			"php_version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The PHP version of the server.",
+				MarkdownDescription: "The PHP version of the server.",
+			},
+			"provider_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The provider of the server.",
+				MarkdownDescription: "The provider of the server.",
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The region where the server is located.",
+				MarkdownDescription: "The region where the server is located.",
+			},
+			"servers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connection_status": schema.StringAttribute{
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date and time the server was created.",
+							MarkdownDescription: "The date and time the server was created.",
+						},
+						"credential_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"database_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"db_status": schema.StringAttribute{
+							Computed: true,
+						},
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"identifier": schema.StringAttribute{
+							Computed: true,
+						},
+						"ip_address": schema.StringAttribute{
+							Computed: true,
+						},
+						"is_ready": schema.BoolAttribute{
+							Computed: true,
+						},
+						"local_public_key": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"opcache_status": schema.StringAttribute{
+							Computed: true,
+						},
+						"php_cli_version": schema.StringAttribute{
+							Computed: true,
+						},
+						"php_version": schema.StringAttribute{
+							Computed: true,
+						},
+						"private_ip_address": schema.StringAttribute{
+							Computed: true,
+						},
+						"provider": schema.StringAttribute{
+							Computed: true,
+						},
+						"redis_status": schema.StringAttribute{
+							Computed: true,
+						},
+						"region": schema.StringAttribute{
+							Computed: true,
+						},
+						"revoked": schema.BoolAttribute{
+							Computed: true,
+						},
+						"size": schema.StringAttribute{
+							Computed: true,
+						},
+						"ssh_port": schema.Int64Attribute{
+							Computed: true,
+						},
+						"timezone": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"ubuntu_version": schema.StringAttribute{
+							Computed: true,
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date and time the server was last updated.",
+							MarkdownDescription: "The date and time the server was last updated.",
+						},
+					},
+					CustomType: ServersType{
+						ObjectType: types.ObjectType{
+							AttrTypes: ServersValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Computed: true,
+			},
+			"size": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The size of the server.",
+				MarkdownDescription: "The size of the server.",
+			},
+			"ubuntu_version": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The Ubuntu version of the server.",
+				MarkdownDescription: "The Ubuntu version of the server.",
+			},
+		},
+	}
+}
+
+type ServersModel struct {
+	DatabaseType  types.String `tfsdk:"database_type"`
+	IpAddress     types.String `tfsdk:"ip_address"`
+	Name          types.String `tfsdk:"name"`
+	Organization  types.String `tfsdk:"organization"`
+	PhpVersion    types.String `tfsdk:"php_version"`
+	ProviderName  types.String `tfsdk:"provider_name"`
+	Region        types.String `tfsdk:"region"`
+	Servers       types.List   `tfsdk:"servers"`
+	Size          types.String `tfsdk:"size"`
+	UbuntuVersion types.String `tfsdk:"ubuntu_version"`
+}
+
+var _ basetypes.ObjectTypable = ServersType{}
+
+type ServersType struct {
+	basetypes.ObjectType
+}
+
+func (t ServersType) Equal(o attr.Type) bool {
+	other, ok := o.(ServersType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t ServersType) String() string {
+	return "ServersType"
+}
+
+func (t ServersType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	connectionStatusAttribute, ok := attributes["connection_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connection_status is missing from object`)
+
+		return nil, diags
+	}
+
+	connectionStatusVal, ok := connectionStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connection_status expected to be basetypes.StringValue, was: %T`, connectionStatusAttribute))
+	}
+
+	createdAtAttribute, ok := attributes["created_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`created_at is missing from object`)
+
+		return nil, diags
+	}
+
+	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
+	}
+
+	credentialIdAttribute, ok := attributes["credential_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`credential_id is missing from object`)
+
+		return nil, diags
+	}
+
+	credentialIdVal, ok := credentialIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`credential_id expected to be basetypes.Int64Value, was: %T`, credentialIdAttribute))
+	}
+
+	databaseTypeAttribute, ok := attributes["database_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database_type is missing from object`)
+
+		return nil, diags
+	}
+
+	databaseTypeVal, ok := databaseTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database_type expected to be basetypes.StringValue, was: %T`, databaseTypeAttribute))
+	}
+
+	dbStatusAttribute, ok := attributes["db_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`db_status is missing from object`)
+
+		return nil, diags
+	}
+
+	dbStatusVal, ok := dbStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`db_status expected to be basetypes.StringValue, was: %T`, dbStatusAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return nil, diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	identifierAttribute, ok := attributes["identifier"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identifier is missing from object`)
+
+		return nil, diags
+	}
+
+	identifierVal, ok := identifierAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identifier expected to be basetypes.StringValue, was: %T`, identifierAttribute))
+	}
+
+	ipAddressAttribute, ok := attributes["ip_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ip_address is missing from object`)
+
+		return nil, diags
+	}
+
+	ipAddressVal, ok := ipAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ip_address expected to be basetypes.StringValue, was: %T`, ipAddressAttribute))
+	}
+
+	isReadyAttribute, ok := attributes["is_ready"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`is_ready is missing from object`)
+
+		return nil, diags
+	}
+
+	isReadyVal, ok := isReadyAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`is_ready expected to be basetypes.BoolValue, was: %T`, isReadyAttribute))
+	}
+
+	localPublicKeyAttribute, ok := attributes["local_public_key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`local_public_key is missing from object`)
+
+		return nil, diags
+	}
+
+	localPublicKeyVal, ok := localPublicKeyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`local_public_key expected to be basetypes.StringValue, was: %T`, localPublicKeyAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return nil, diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	opcacheStatusAttribute, ok := attributes["opcache_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`opcache_status is missing from object`)
+
+		return nil, diags
+	}
+
+	opcacheStatusVal, ok := opcacheStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`opcache_status expected to be basetypes.StringValue, was: %T`, opcacheStatusAttribute))
+	}
+
+	phpCliVersionAttribute, ok := attributes["php_cli_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`php_cli_version is missing from object`)
+
+		return nil, diags
+	}
+
+	phpCliVersionVal, ok := phpCliVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`php_cli_version expected to be basetypes.StringValue, was: %T`, phpCliVersionAttribute))
+	}
+
+	phpVersionAttribute, ok := attributes["php_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`php_version is missing from object`)
+
+		return nil, diags
+	}
+
+	phpVersionVal, ok := phpVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`php_version expected to be basetypes.StringValue, was: %T`, phpVersionAttribute))
+	}
+
+	privateIpAddressAttribute, ok := attributes["private_ip_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`private_ip_address is missing from object`)
+
+		return nil, diags
+	}
+
+	privateIpAddressVal, ok := privateIpAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`private_ip_address expected to be basetypes.StringValue, was: %T`, privateIpAddressAttribute))
+	}
+
+	providerAttribute, ok := attributes["provider"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`provider is missing from object`)
+
+		return nil, diags
+	}
+
+	providerVal, ok := providerAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`provider expected to be basetypes.StringValue, was: %T`, providerAttribute))
+	}
+
+	redisStatusAttribute, ok := attributes["redis_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`redis_status is missing from object`)
+
+		return nil, diags
+	}
+
+	redisStatusVal, ok := redisStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`redis_status expected to be basetypes.StringValue, was: %T`, redisStatusAttribute))
+	}
+
+	regionAttribute, ok := attributes["region"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`region is missing from object`)
+
+		return nil, diags
+	}
+
+	regionVal, ok := regionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`region expected to be basetypes.StringValue, was: %T`, regionAttribute))
+	}
+
+	revokedAttribute, ok := attributes["revoked"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`revoked is missing from object`)
+
+		return nil, diags
+	}
+
+	revokedVal, ok := revokedAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`revoked expected to be basetypes.BoolValue, was: %T`, revokedAttribute))
+	}
+
+	sizeAttribute, ok := attributes["size"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size is missing from object`)
+
+		return nil, diags
+	}
+
+	sizeVal, ok := sizeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size expected to be basetypes.StringValue, was: %T`, sizeAttribute))
+	}
+
+	sshPortAttribute, ok := attributes["ssh_port"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ssh_port is missing from object`)
+
+		return nil, diags
+	}
+
+	sshPortVal, ok := sshPortAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ssh_port expected to be basetypes.Int64Value, was: %T`, sshPortAttribute))
+	}
+
+	timezoneAttribute, ok := attributes["timezone"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`timezone is missing from object`)
+
+		return nil, diags
+	}
+
+	timezoneVal, ok := timezoneAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`timezone expected to be basetypes.StringValue, was: %T`, timezoneAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	ubuntuVersionAttribute, ok := attributes["ubuntu_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ubuntu_version is missing from object`)
+
+		return nil, diags
+	}
+
+	ubuntuVersionVal, ok := ubuntuVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ubuntu_version expected to be basetypes.StringValue, was: %T`, ubuntuVersionAttribute))
+	}
+
+	updatedAtAttribute, ok := attributes["updated_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`updated_at is missing from object`)
+
+		return nil, diags
+	}
+
+	updatedAtVal, ok := updatedAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`updated_at expected to be basetypes.StringValue, was: %T`, updatedAtAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return ServersValue{
+		ConnectionStatus: connectionStatusVal,
+		CreatedAt:        createdAtVal,
+		CredentialId:     credentialIdVal,
+		DatabaseType:     databaseTypeVal,
+		DbStatus:         dbStatusVal,
+		Id:               idVal,
+		Identifier:       identifierVal,
+		IpAddress:        ipAddressVal,
+		IsReady:          isReadyVal,
+		LocalPublicKey:   localPublicKeyVal,
+		Name:             nameVal,
+		OpcacheStatus:    opcacheStatusVal,
+		PhpCliVersion:    phpCliVersionVal,
+		PhpVersion:       phpVersionVal,
+		PrivateIpAddress: privateIpAddressVal,
+		Provider:         providerVal,
+		RedisStatus:      redisStatusVal,
+		Region:           regionVal,
+		Revoked:          revokedVal,
+		Size:             sizeVal,
+		SshPort:          sshPortVal,
+		Timezone:         timezoneVal,
+		ServersType:      typeVal,
+		UbuntuVersion:    ubuntuVersionVal,
+		UpdatedAt:        updatedAtVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewServersValueNull() ServersValue {
+	return ServersValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewServersValueUnknown() ServersValue {
+	return ServersValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewServersValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (ServersValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing ServersValue Attribute Value",
+				"While creating a ServersValue value, a missing attribute value was detected. "+
+					"A ServersValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ServersValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid ServersValue Attribute Type",
+				"While creating a ServersValue value, an invalid attribute value was detected. "+
+					"A ServersValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("ServersValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("ServersValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra ServersValue Attribute Value",
+				"While creating a ServersValue value, an extra attribute value was detected. "+
+					"A ServersValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra ServersValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewServersValueUnknown(), diags
+	}
+
+	connectionStatusAttribute, ok := attributes["connection_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`connection_status is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	connectionStatusVal, ok := connectionStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`connection_status expected to be basetypes.StringValue, was: %T`, connectionStatusAttribute))
+	}
+
+	createdAtAttribute, ok := attributes["created_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`created_at is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
+	}
+
+	credentialIdAttribute, ok := attributes["credential_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`credential_id is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	credentialIdVal, ok := credentialIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`credential_id expected to be basetypes.Int64Value, was: %T`, credentialIdAttribute))
+	}
+
+	databaseTypeAttribute, ok := attributes["database_type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`database_type is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	databaseTypeVal, ok := databaseTypeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`database_type expected to be basetypes.StringValue, was: %T`, databaseTypeAttribute))
+	}
+
+	dbStatusAttribute, ok := attributes["db_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`db_status is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	dbStatusVal, ok := dbStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`db_status expected to be basetypes.StringValue, was: %T`, dbStatusAttribute))
+	}
+
+	idAttribute, ok := attributes["id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`id is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	idVal, ok := idAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
+	}
+
+	identifierAttribute, ok := attributes["identifier"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`identifier is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	identifierVal, ok := identifierAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`identifier expected to be basetypes.StringValue, was: %T`, identifierAttribute))
+	}
+
+	ipAddressAttribute, ok := attributes["ip_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ip_address is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	ipAddressVal, ok := ipAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ip_address expected to be basetypes.StringValue, was: %T`, ipAddressAttribute))
+	}
+
+	isReadyAttribute, ok := attributes["is_ready"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`is_ready is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	isReadyVal, ok := isReadyAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`is_ready expected to be basetypes.BoolValue, was: %T`, isReadyAttribute))
+	}
+
+	localPublicKeyAttribute, ok := attributes["local_public_key"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`local_public_key is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	localPublicKeyVal, ok := localPublicKeyAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`local_public_key expected to be basetypes.StringValue, was: %T`, localPublicKeyAttribute))
+	}
+
+	nameAttribute, ok := attributes["name"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`name is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	nameVal, ok := nameAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`name expected to be basetypes.StringValue, was: %T`, nameAttribute))
+	}
+
+	opcacheStatusAttribute, ok := attributes["opcache_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`opcache_status is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	opcacheStatusVal, ok := opcacheStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`opcache_status expected to be basetypes.StringValue, was: %T`, opcacheStatusAttribute))
+	}
+
+	phpCliVersionAttribute, ok := attributes["php_cli_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`php_cli_version is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	phpCliVersionVal, ok := phpCliVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`php_cli_version expected to be basetypes.StringValue, was: %T`, phpCliVersionAttribute))
+	}
+
+	phpVersionAttribute, ok := attributes["php_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`php_version is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	phpVersionVal, ok := phpVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`php_version expected to be basetypes.StringValue, was: %T`, phpVersionAttribute))
+	}
+
+	privateIpAddressAttribute, ok := attributes["private_ip_address"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`private_ip_address is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	privateIpAddressVal, ok := privateIpAddressAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`private_ip_address expected to be basetypes.StringValue, was: %T`, privateIpAddressAttribute))
+	}
+
+	providerAttribute, ok := attributes["provider"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`provider is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	providerVal, ok := providerAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`provider expected to be basetypes.StringValue, was: %T`, providerAttribute))
+	}
+
+	redisStatusAttribute, ok := attributes["redis_status"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`redis_status is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	redisStatusVal, ok := redisStatusAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`redis_status expected to be basetypes.StringValue, was: %T`, redisStatusAttribute))
+	}
+
+	regionAttribute, ok := attributes["region"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`region is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	regionVal, ok := regionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`region expected to be basetypes.StringValue, was: %T`, regionAttribute))
+	}
+
+	revokedAttribute, ok := attributes["revoked"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`revoked is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	revokedVal, ok := revokedAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`revoked expected to be basetypes.BoolValue, was: %T`, revokedAttribute))
+	}
+
+	sizeAttribute, ok := attributes["size"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`size is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	sizeVal, ok := sizeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`size expected to be basetypes.StringValue, was: %T`, sizeAttribute))
+	}
+
+	sshPortAttribute, ok := attributes["ssh_port"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ssh_port is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	sshPortVal, ok := sshPortAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ssh_port expected to be basetypes.Int64Value, was: %T`, sshPortAttribute))
+	}
+
+	timezoneAttribute, ok := attributes["timezone"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`timezone is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	timezoneVal, ok := timezoneAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`timezone expected to be basetypes.StringValue, was: %T`, timezoneAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	ubuntuVersionAttribute, ok := attributes["ubuntu_version"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`ubuntu_version is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	ubuntuVersionVal, ok := ubuntuVersionAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`ubuntu_version expected to be basetypes.StringValue, was: %T`, ubuntuVersionAttribute))
+	}
+
+	updatedAtAttribute, ok := attributes["updated_at"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`updated_at is missing from object`)
+
+		return NewServersValueUnknown(), diags
+	}
+
+	updatedAtVal, ok := updatedAtAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`updated_at expected to be basetypes.StringValue, was: %T`, updatedAtAttribute))
+	}
+
+	if diags.HasError() {
+		return NewServersValueUnknown(), diags
+	}
+
+	return ServersValue{
+		ConnectionStatus: connectionStatusVal,
+		CreatedAt:        createdAtVal,
+		CredentialId:     credentialIdVal,
+		DatabaseType:     databaseTypeVal,
+		DbStatus:         dbStatusVal,
+		Id:               idVal,
+		Identifier:       identifierVal,
+		IpAddress:        ipAddressVal,
+		IsReady:          isReadyVal,
+		LocalPublicKey:   localPublicKeyVal,
+		Name:             nameVal,
+		OpcacheStatus:    opcacheStatusVal,
+		PhpCliVersion:    phpCliVersionVal,
+		PhpVersion:       phpVersionVal,
+		PrivateIpAddress: privateIpAddressVal,
+		Provider:         providerVal,
+		RedisStatus:      redisStatusVal,
+		Region:           regionVal,
+		Revoked:          revokedVal,
+		Size:             sizeVal,
+		SshPort:          sshPortVal,
+		Timezone:         timezoneVal,
+		ServersType:      typeVal,
+		UbuntuVersion:    ubuntuVersionVal,
+		UpdatedAt:        updatedAtVal,
+		state:            attr.ValueStateKnown,
+	}, diags
+}
+
+func NewServersValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) ServersValue {
+	object, diags := NewServersValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewServersValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t ServersType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewServersValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewServersValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewServersValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewServersValueMust(ServersValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t ServersType) ValueType(ctx context.Context) attr.Value {
+	return ServersValue{}
+}
+
+var _ basetypes.ObjectValuable = ServersValue{}
+
+type ServersValue struct {
+	ConnectionStatus basetypes.StringValue `tfsdk:"connection_status"`
+	CreatedAt        basetypes.StringValue `tfsdk:"created_at"`
+	CredentialId     basetypes.Int64Value  `tfsdk:"credential_id"`
+	DatabaseType     basetypes.StringValue `tfsdk:"database_type"`
+	DbStatus         basetypes.StringValue `tfsdk:"db_status"`
+	Id               basetypes.StringValue `tfsdk:"id"`
+	Identifier       basetypes.StringValue `tfsdk:"identifier"`
+	IpAddress        basetypes.StringValue `tfsdk:"ip_address"`
+	IsReady          basetypes.BoolValue   `tfsdk:"is_ready"`
+	LocalPublicKey   basetypes.StringValue `tfsdk:"local_public_key"`
+	Name             basetypes.StringValue `tfsdk:"name"`
+	OpcacheStatus    basetypes.StringValue `tfsdk:"opcache_status"`
+	PhpCliVersion    basetypes.StringValue `tfsdk:"php_cli_version"`
+	PhpVersion       basetypes.StringValue `tfsdk:"php_version"`
+	PrivateIpAddress basetypes.StringValue `tfsdk:"private_ip_address"`
+	Provider         basetypes.StringValue `tfsdk:"provider"`
+	RedisStatus      basetypes.StringValue `tfsdk:"redis_status"`
+	Region           basetypes.StringValue `tfsdk:"region"`
+	Revoked          basetypes.BoolValue   `tfsdk:"revoked"`
+	Size             basetypes.StringValue `tfsdk:"size"`
+	SshPort          basetypes.Int64Value  `tfsdk:"ssh_port"`
+	Timezone         basetypes.StringValue `tfsdk:"timezone"`
+	ServersType      basetypes.StringValue `tfsdk:"type"`
+	UbuntuVersion    basetypes.StringValue `tfsdk:"ubuntu_version"`
+	UpdatedAt        basetypes.StringValue `tfsdk:"updated_at"`
+	state            attr.ValueState
+}
+
+func (v ServersValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 25)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["connection_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["created_at"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["credential_id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["database_type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["db_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["identifier"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ip_address"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["is_ready"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["local_public_key"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["name"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["opcache_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["php_cli_version"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["php_version"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["private_ip_address"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["provider"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["redis_status"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["region"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["revoked"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["size"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ssh_port"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["timezone"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["ubuntu_version"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["updated_at"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 25)
+
+		val, err = v.ConnectionStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["connection_status"] = val
+
+		val, err = v.CreatedAt.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["created_at"] = val
+
+		val, err = v.CredentialId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["credential_id"] = val
+
+		val, err = v.DatabaseType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["database_type"] = val
+
+		val, err = v.DbStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["db_status"] = val
+
+		val, err = v.Id.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["id"] = val
+
+		val, err = v.Identifier.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["identifier"] = val
+
+		val, err = v.IpAddress.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ip_address"] = val
+
+		val, err = v.IsReady.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["is_ready"] = val
+
+		val, err = v.LocalPublicKey.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["local_public_key"] = val
+
+		val, err = v.Name.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["name"] = val
+
+		val, err = v.OpcacheStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["opcache_status"] = val
+
+		val, err = v.PhpCliVersion.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["php_cli_version"] = val
+
+		val, err = v.PhpVersion.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["php_version"] = val
+
+		val, err = v.PrivateIpAddress.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["private_ip_address"] = val
+
+		val, err = v.Provider.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["provider"] = val
+
+		val, err = v.RedisStatus.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["redis_status"] = val
+
+		val, err = v.Region.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["region"] = val
+
+		val, err = v.Revoked.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["revoked"] = val
+
+		val, err = v.Size.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["size"] = val
+
+		val, err = v.SshPort.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ssh_port"] = val
+
+		val, err = v.Timezone.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["timezone"] = val
+
+		val, err = v.ServersType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		val, err = v.UbuntuVersion.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["ubuntu_version"] = val
+
+		val, err = v.UpdatedAt.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["updated_at"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v ServersValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v ServersValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v ServersValue) String() string {
+	return "ServersValue"
+}
+
+func (v ServersValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"connection_status":  basetypes.StringType{},
+		"created_at":         basetypes.StringType{},
+		"credential_id":      basetypes.Int64Type{},
+		"database_type":      basetypes.StringType{},
+		"db_status":          basetypes.StringType{},
+		"id":                 basetypes.StringType{},
+		"identifier":         basetypes.StringType{},
+		"ip_address":         basetypes.StringType{},
+		"is_ready":           basetypes.BoolType{},
+		"local_public_key":   basetypes.StringType{},
+		"name":               basetypes.StringType{},
+		"opcache_status":     basetypes.StringType{},
+		"php_cli_version":    basetypes.StringType{},
+		"php_version":        basetypes.StringType{},
+		"private_ip_address": basetypes.StringType{},
+		"provider":           basetypes.StringType{},
+		"redis_status":       basetypes.StringType{},
+		"region":             basetypes.StringType{},
+		"revoked":            basetypes.BoolType{},
+		"size":               basetypes.StringType{},
+		"ssh_port":           basetypes.Int64Type{},
+		"timezone":           basetypes.StringType{},
+		"type":               basetypes.StringType{},
+		"ubuntu_version":     basetypes.StringType{},
+		"updated_at":         basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"connection_status":  v.ConnectionStatus,
+			"created_at":         v.CreatedAt,
+			"credential_id":      v.CredentialId,
+			"database_type":      v.DatabaseType,
+			"db_status":          v.DbStatus,
+			"id":                 v.Id,
+			"identifier":         v.Identifier,
+			"ip_address":         v.IpAddress,
+			"is_ready":           v.IsReady,
+			"local_public_key":   v.LocalPublicKey,
+			"name":               v.Name,
+			"opcache_status":     v.OpcacheStatus,
+			"php_cli_version":    v.PhpCliVersion,
+			"php_version":        v.PhpVersion,
+			"private_ip_address": v.PrivateIpAddress,
+			"provider":           v.Provider,
+			"redis_status":       v.RedisStatus,
+			"region":             v.Region,
+			"revoked":            v.Revoked,
+			"size":               v.Size,
+			"ssh_port":           v.SshPort,
+			"timezone":           v.Timezone,
+			"type":               v.ServersType,
+			"ubuntu_version":     v.UbuntuVersion,
+			"updated_at":         v.UpdatedAt,
+		})
+
+	return objVal, diags
+}
+
+func (v ServersValue) Equal(o attr.Value) bool {
+	other, ok := o.(ServersValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.ConnectionStatus.Equal(other.ConnectionStatus) {
+		return false
+	}
+
+	if !v.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+
+	if !v.CredentialId.Equal(other.CredentialId) {
+		return false
+	}
+
+	if !v.DatabaseType.Equal(other.DatabaseType) {
+		return false
+	}
+
+	if !v.DbStatus.Equal(other.DbStatus) {
+		return false
+	}
+
+	if !v.Id.Equal(other.Id) {
+		return false
+	}
+
+	if !v.Identifier.Equal(other.Identifier) {
+		return false
+	}
+
+	if !v.IpAddress.Equal(other.IpAddress) {
+		return false
+	}
+
+	if !v.IsReady.Equal(other.IsReady) {
+		return false
+	}
+
+	if !v.LocalPublicKey.Equal(other.LocalPublicKey) {
+		return false
+	}
+
+	if !v.Name.Equal(other.Name) {
+		return false
+	}
+
+	if !v.OpcacheStatus.Equal(other.OpcacheStatus) {
+		return false
+	}
+
+	if !v.PhpCliVersion.Equal(other.PhpCliVersion) {
+		return false
+	}
+
+	if !v.PhpVersion.Equal(other.PhpVersion) {
+		return false
+	}
+
+	if !v.PrivateIpAddress.Equal(other.PrivateIpAddress) {
+		return false
+	}
+
+	if !v.Provider.Equal(other.Provider) {
+		return false
+	}
+
+	if !v.RedisStatus.Equal(other.RedisStatus) {
+		return false
+	}
+
+	if !v.Region.Equal(other.Region) {
+		return false
+	}
+
+	if !v.Revoked.Equal(other.Revoked) {
+		return false
+	}
+
+	if !v.Size.Equal(other.Size) {
+		return false
+	}
+
+	if !v.SshPort.Equal(other.SshPort) {
+		return false
+	}
+
+	if !v.Timezone.Equal(other.Timezone) {
+		return false
+	}
+
+	if !v.ServersType.Equal(other.ServersType) {
+		return false
+	}
+
+	if !v.UbuntuVersion.Equal(other.UbuntuVersion) {
+		return false
+	}
+
+	if !v.UpdatedAt.Equal(other.UpdatedAt) {
+		return false
+	}
+
+	return true
+}
+
+func (v ServersValue) Type(ctx context.Context) attr.Type {
+	return ServersType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v ServersValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"connection_status":  basetypes.StringType{},
+		"created_at":         basetypes.StringType{},
+		"credential_id":      basetypes.Int64Type{},
+		"database_type":      basetypes.StringType{},
+		"db_status":          basetypes.StringType{},
+		"id":                 basetypes.StringType{},
+		"identifier":         basetypes.StringType{},
+		"ip_address":         basetypes.StringType{},
+		"is_ready":           basetypes.BoolType{},
+		"local_public_key":   basetypes.StringType{},
+		"name":               basetypes.StringType{},
+		"opcache_status":     basetypes.StringType{},
+		"php_cli_version":    basetypes.StringType{},
+		"php_version":        basetypes.StringType{},
+		"private_ip_address": basetypes.StringType{},
+		"provider":           basetypes.StringType{},
+		"redis_status":       basetypes.StringType{},
+		"region":             basetypes.StringType{},
+		"revoked":            basetypes.BoolType{},
+		"size":               basetypes.StringType{},
+		"ssh_port":           basetypes.Int64Type{},
+		"timezone":           basetypes.StringType{},
+		"type":               basetypes.StringType{},
+		"ubuntu_version":     basetypes.StringType{},
+		"updated_at":         basetypes.StringType{},
+	}
+}
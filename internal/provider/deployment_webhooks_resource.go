@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_deployment_webhooks"
+)
+
+// NewDeploymentWebhooksResource returns the laravelforge_deployment_webhooks
+// resource, which manages a webhook called after each deployment of a site.
+func NewDeploymentWebhooksResource() resource.Resource {
+	return &apiResource{
+		name:   "deployment_webhooks",
+		schema: resource_deployment_webhooks.DeploymentWebhooksResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/webhooks"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/webhooks/{deploymentWebhook}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/webhooks/{deploymentWebhook}"},
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_deployments"
+)
+
+// NewDeploymentsResource returns the laravelforge_deployments resource, which
+// manages a deployment of a site. Deployments cannot be deleted, destroying
+// the resource only removes it from the state.
+func NewDeploymentsResource() resource.Resource {
+	return &apiResource{
+		name:   "deployments",
+		schema: resource_deployments.DeploymentsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/{deployment}"},
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_domain_action"
+)
+
+// NewDomainActionResource returns the laravelforge_domain_action resource,
+// which enables, disables or marks a domain of a site as primary when it is
+// created or its triggers change.
+func NewDomainActionResource() resource.Resource {
+	return &actionResource{&apiResource{
+		name:   "domain_action",
+		schema: resource_domain_action.DomainActionResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/actions"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}"},
+
+		local: []string{"triggers"},
+	}}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_domain_certificate_action"
+)
+
+// NewDomainCertificateActionResource returns the
+// laravelforge_domain_certificate_action resource, which enables or disables
+// the certificate of a domain when it is created or its triggers change.
+func NewDomainCertificateActionResource() resource.Resource {
+	return &actionResource{&apiResource{
+		name:   "domain_certificate_action",
+		schema: resource_domain_certificate_action.DomainCertificateActionResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/certificate/actions"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/certificate"},
+
+		local: []string{"triggers"},
+	}}
+}
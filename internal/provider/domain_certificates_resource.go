@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_domain_certificates"
+)
+
+var (
+	_ resource.ResourceWithConfigValidators = &domainCertificatesResource{}
+	_ resource.ResourceWithValidateConfig   = &domainCertificatesResource{}
+)
+
+// domainCertificatesResource manages the certificate of a domain. Laravel
+// Forge installs certificates asynchronously and only serves them once they
+// are activated, so creating one waits for it to be installed and then
+// activates it unless activate is false. Changing activate enables or
+// disables the certificate in place.
+//
+// Let's Encrypt certificates can fail to verify the domain. They stay in the
+// state when they do, so Terraform taints them and the next apply creates
+// them again.
+type domainCertificatesResource struct {
+	*apiResource
+}
+
+// certificateSources are the attributes that configure each type of
+// certificate, named after the type.
+var certificateSources = []string{"letsencrypt", "existing", "csr", "clone"}
+
+// NewDomainCertificatesResource returns the laravelforge_domain_certificates
+// resource, which manages the certificate of a site domain.
+func NewDomainCertificatesResource() resource.Resource {
+	return &domainCertificatesResource{&apiResource{
+		name:   "domain_certificates",
+		schema: resource_domain_certificates.DomainCertificatesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/certificate"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/certificate"},
+		update: &operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/certificate/actions"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/certificate"},
+
+		// Only activate is updated, through the actions of the certificate.
+		updateFields: map[string]string{"activate": "action"},
+		local:        []string{"activate"},
+
+		// type is only sent when creating the certificate. The API reports
+		// cloned certificates with the type of the original, so the
+		// configured type is kept.
+		equal: map[string]func(state, read string) bool{
+			"type": func(string, string) bool { return true },
+		},
+
+		ready:         certificateReady,
+		createTimeout: 15 * time.Minute,
+	}}
+}
+
+func (r *domainCertificatesResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	var sources []path.Expression
+	for _, source := range certificateSources {
+		sources = append(sources, path.MatchRoot(source))
+	}
+
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(sources...),
+	}
+}
+
+// ValidateConfig checks that the configured certificate source matches the
+// type, and that an existing certificate and key are valid PEM and belong
+// together.
+func (r *domainCertificatesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config, err := objectValues(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	if isSet(config["type"]) {
+		var certificateType string
+		_ = config["type"].As(&certificateType)
+
+		for _, source := range certificateSources {
+			if source != certificateType && isSet(config[source]) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(source),
+					"Invalid attribute combination",
+					fmt.Sprintf("The %s attribute can only be set when type is %s, type is %s.", source, source, certificateType),
+				)
+			}
+		}
+	}
+
+	if !isSet(config["existing"]) {
+		return
+	}
+
+	existing, err := objectValues(config["existing"])
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	for _, name := range []string{"certificate", "key"} {
+		if existing[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("existing").AtName(name),
+				"Missing attribute",
+				fmt.Sprintf("The existing.%s attribute is required for existing certificates.", name),
+			)
+		}
+	}
+
+	var certificates []*x509.Certificate
+
+	if isSet(existing["certificate"]) {
+		var chain string
+		_ = existing["certificate"].As(&chain)
+
+		if certificates, err = pemCertificates(chain); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("existing").AtName("certificate"), "Invalid certificate", "The certificate must be a PEM encoded certificate chain: "+err.Error())
+		}
+	}
+
+	var publicKey crypto.PublicKey
+
+	if isSet(existing["key"]) {
+		var key string
+		_ = existing["key"].As(&key)
+
+		// The error does not include the key, which is sensitive.
+		if publicKey, err = pemPrivateKey(key); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("existing").AtName("key"), "Invalid private key", "The key must be a PEM encoded private key: "+err.Error())
+		}
+	}
+
+	if len(certificates) > 0 && publicKey != nil && !samePublicKey(certificates[0].PublicKey, publicKey) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("existing").AtName("key"),
+			"Private key does not match certificate",
+			"The key must be the private key of the first certificate of the chain, which is the certificate of the domain.",
+		)
+	}
+}
+
+// ModifyPlan replaces certificates that failed after they were created, such
+// as imported ones, as Terraform only taints the ones that fail while it
+// creates them.
+func (r *domainCertificatesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.apiResource.ModifyPlan(ctx, req, resp)
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	var status string
+	if isSet(state["status"]) {
+		_ = state["status"].As(&status)
+	}
+
+	if !certificateFailed(status) {
+		return
+	}
+
+	plan, err := objectValues(resp.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	plan["status"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	resp.Plan.Raw = tftypes.NewValue(req.Plan.Raw.Type(), plan)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("status"))
+}
+
+func (r *domainCertificatesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.apiResource.Create(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := objectValues(resp.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	var certificateType string
+	_ = state["type"].As(&certificateType)
+
+	// A certificate signing request has no certificate to activate until
+	// the signed certificate is installed.
+	if certificateType == "csr" || !activated(state) {
+		return
+	}
+
+	r.action(ctx, state, "enable", &resp.Diagnostics)
+}
+
+// Update enables or disables the certificate, activate being the only
+// attribute that does not replace it, and reads it back.
+func (r *domainCertificatesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	action := "disable"
+	if activated(plan) {
+		action = "enable"
+	}
+
+	if !r.action(ctx, plan, action, &resp.Diagnostics) {
+		return
+	}
+
+	data, ok := r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+}
+
+// action runs the enable or disable action on the certificate.
+func (r *domainCertificatesResource) action(ctx context.Context, values map[string]tftypes.Value, action string, diags *diag.Diagnostics) bool {
+	_, ok := r.do(ctx, *r.update, values, map[string]any{"action": action}, action, diags)
+
+	return ok
+}
+
+// activated reports whether the certificate in values is to be activated,
+// which it is unless activate is false.
+func activated(values map[string]tftypes.Value) bool {
+	activate := true
+	if isSet(values["activate"]) {
+		_ = values["activate"].As(&activate)
+	}
+
+	return activate
+}
+
+// certificateReady reports whether a certificate is installed. Certificate
+// signing requests are ready as soon as they are created, Laravel Forge only
+// installs the certificate once it is signed.
+func certificateReady(attributes map[string]any) (string, bool, error) {
+	certificateType, _ := attributes["type"].(string)
+	requestStatus, _ := attributes["request_status"].(string)
+	status, _ := attributes["status"].(string)
+	description := fmt.Sprintf("request_status=%q, status=%q", requestStatus, status)
+
+	if certificateFailed(status) {
+		return description, false, certificateError(attributes, description)
+	}
+
+	return description, certificateType == "csr" || status == "installed", nil
+}
+
+// certificateFailed reports whether the status of a certificate is one of
+// the failed states.
+func certificateFailed(status string) bool {
+	return strings.HasPrefix(status, "failed")
+}
+
+// certificateError describes why issuing or installing a certificate
+// failed. Let's Encrypt certificates mostly fail to verify the domain, so the
+// error names the verification method and what it depends on.
+func certificateError(attributes map[string]any, description string) error {
+	method, _ := attributes["verification_method"].(string)
+
+	var hint string
+
+	switch method {
+	case "dns-01":
+		hint = " Let's Encrypt verifies the domain through the _acme-challenge TXT record, check that the DNS of the domain serves it."
+	case "http-01":
+		hint = " Let's Encrypt verifies the domain over HTTP, check that the domain resolves to the server and that port 80 is reachable."
+	}
+
+	if method != "" {
+		description = fmt.Sprintf("verification_method=%q, %s", method, description)
+	}
+
+	return fmt.Errorf("installing the certificate failed, last status: %s.%s The certificate is tainted and created again by the next apply.", description, hint)
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+// certificateStatuses are the values of the ResourceState enum of the
+// OpenAPI document, which the status of certificates uses.
+var certificateStatuses = []string{
+	"installing", "installed", "removing", "restarting", "stopping", "stopped",
+	"starting", "syncing", "updating", "disabling", "disabled", "enabling",
+	"running", "restoring", "deleting", "failed", "success", "failed-unknown",
+	"failed-runner", "renewing",
+}
+
+func TestCertificateReady(t *testing.T) {
+	for _, certificateType := range []string{"letsencrypt", "existing", "csr"} {
+		for _, status := range certificateStatuses {
+			t.Run(certificateType+" "+status, func(t *testing.T) {
+				attributes := map[string]any{"type": certificateType, "request_status": "created", "status": status}
+
+				wantErr := strings.HasPrefix(status, "failed")
+				wantReady := !wantErr && (certificateType == "csr" || status == "installed")
+
+				_, ready, err := certificateReady(attributes)
+
+				if (err != nil) != wantErr {
+					t.Fatalf("certificateReady() error = %v, want error %t", err, wantErr)
+				}
+
+				if ready != wantReady {
+					t.Errorf("certificateReady() = %t, want %t", ready, wantReady)
+				}
+			})
+		}
+	}
+
+	if _, ready, err := certificateReady(nil); ready || err != nil {
+		t.Errorf("certificateReady(nil) = %t, %v, want false, nil", ready, err)
+	}
+}
+
+func TestCertificateError(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		want       []string
+	}{
+		{
+			name:       "dns-01",
+			attributes: map[string]any{"verification_method": "dns-01"},
+			want:       []string{`verification_method="dns-01", status="failed"`, "_acme-challenge TXT record", "tainted"},
+		},
+		{
+			name:       "http-01",
+			attributes: map[string]any{"verification_method": "http-01"},
+			want:       []string{`verification_method="http-01", status="failed"`, "port 80", "tainted"},
+		},
+		{
+			name:       "no verification method",
+			attributes: map[string]any{"verification_method": nil},
+			want:       []string{`last status: status="failed".`, "tainted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := certificateError(tt.attributes, `status="failed"`)
+
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("certificateError() = %q, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDomainCertificatesModifyPlan(t *testing.T) {
+	r := NewDomainCertificatesResource().(*domainCertificatesResource)
+
+	var schemaResp resource.SchemaResponse
+
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	for _, status := range certificateStatuses {
+		t.Run(status, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, typ := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+
+			values["organization"] = tftypes.NewValue(tftypes.String, "acme")
+			values["server"] = tftypes.NewValue(tftypes.Number, 1)
+			values["site"] = tftypes.NewValue(tftypes.Number, 2)
+			values["domain_record"] = tftypes.NewValue(tftypes.Number, 3)
+			values["type"] = tftypes.NewValue(tftypes.String, "letsencrypt")
+			values["status"] = tftypes.NewValue(tftypes.String, status)
+
+			raw := tftypes.NewValue(objectType, values)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: raw},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+
+			wantReplace := strings.HasPrefix(status, "failed")
+
+			var replace bool
+			for _, p := range resp.RequiresReplace {
+				replace = replace || p.Equal(path.Root("status"))
+			}
+
+			if replace != wantReplace {
+				t.Errorf("ModifyPlan() replaces the certificate = %t, want %t", replace, wantReplace)
+			}
+
+			plan, err := objectValues(resp.Plan.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if known := plan["status"].IsKnown(); known == wantReplace {
+				t.Errorf("ModifyPlan() planned status %s, want it unknown = %t", plan["status"], wantReplace)
+			}
+		})
+	}
+}
+
+func TestDomainCertificatesReadKeepsType(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// prior is the type in the state, null when importing.
+		prior string
+		read  string
+		want  string
+	}{
+		{name: "clone", prior: "clone", read: "existing", want: "clone"},
+		{name: "letsencrypt", prior: "letsencrypt", read: "letsencrypt", want: "letsencrypt"},
+		{name: "import", read: "existing", want: "existing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/orgs/acme/servers/1/sites/2/domains/3/certificate" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				fmt.Fprintf(w, `{"data":{"id":"4","type":"certificates","attributes":{"type":%q,"request_status":"created","status":"installed"}}}`, tt.read)
+			}))
+			defer server.Close()
+
+			client, err := forge.NewClient(forge.Config{BaseURL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := NewDomainCertificatesResource().(*domainCertificatesResource)
+			r.client = client
+
+			var schemaResp resource.SchemaResponse
+
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+			values := map[string]tftypes.Value{}
+			for name, typ := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+
+			values["organization"] = tftypes.NewValue(tftypes.String, "acme")
+			values["server"] = tftypes.NewValue(tftypes.Number, 1)
+			values["site"] = tftypes.NewValue(tftypes.Number, 2)
+			values["domain_record"] = tftypes.NewValue(tftypes.Number, 3)
+
+			if tt.prior != "" {
+				values["type"] = tftypes.NewValue(tftypes.String, tt.prior)
+			}
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+			resp := resource.ReadResponse{State: state}
+
+			r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+
+			got, err := objectValues(resp.State.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := tftypes.NewValue(tftypes.String, tt.want); !got["type"].Equal(want) {
+				t.Errorf("Read() type = %s, want %s", got["type"], want)
+			}
+		})
+	}
+}
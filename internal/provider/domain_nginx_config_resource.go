@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_domain_nginx_config"
+)
+
+// NewDomainNginxConfigResource returns the laravelforge_domain_nginx_config resource,
+// which manages the nginx configuration of a domain.
+func NewDomainNginxConfigResource() resource.Resource {
+	return &nginxConfigResource{&apiResource{
+		name:   "domain_nginx_config",
+		schema: resource_domain_nginx_config.DomainNginxConfigResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/nginx"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/nginx"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}/nginx"},
+
+		bodyFields: map[string]string{"content": "config"},
+		equal:      map[string]func(string, string) bool{"content": sameIgnoringWhitespace},
+	}}
+}
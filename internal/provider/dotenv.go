@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// envKey matches the names of .env variables.
+var envKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// envBareValue matches values that are written without quotes.
+var envBareValue = regexp.MustCompile(`^[A-Za-z0-9_.,:/@+\-]*$`)
+
+// parseEnv returns the variables of a .env file. Blank lines, comments and
+// lines without a variable are ignored.
+func parseEnv(content string) map[string]string {
+	variables := map[string]string{}
+
+	for _, line := range envLines(content) {
+		if key, value, ok := parseEnvLine(line); ok {
+			variables[key] = value
+		}
+	}
+
+	return variables
+}
+
+// envLines splits a .env file into lines, keeping quoted values that span
+// several lines, such as private keys, in a single line.
+func envLines(content string) []string {
+	var lines []string
+
+	open := false
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		if open {
+			lines[len(lines)-1] += "\n" + line
+		} else {
+			lines = append(lines, line)
+		}
+
+		open = unterminatedQuote(lines[len(lines)-1])
+	}
+
+	return lines
+}
+
+// unterminatedQuote reports whether the line sets a variable to a quoted
+// value without its closing quote.
+func unterminatedQuote(line string) bool {
+	key, value, ok := strings.Cut(strings.TrimPrefix(strings.TrimSpace(line), "export "), "=")
+	if !ok || !envKey.MatchString(strings.TrimSpace(key)) {
+		return false
+	}
+
+	value = strings.TrimLeft(value, " \t")
+	if value == "" || (value[0] != '"' && value[0] != '\'') {
+		return false
+	}
+
+	for i := 1; i < len(value); i++ {
+		switch {
+		case value[0] == '"' && value[i] == '\\':
+			i++
+		case value[i] == value[0]:
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseEnvLine returns the variable set by a line of a .env file.
+func parseEnvLine(line string) (key, value string, ok bool) {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "export ")
+
+	key, value, ok = strings.Cut(line, "=")
+	if !ok {
+		return "", "", false
+	}
+
+	key = strings.TrimSpace(key)
+	if !envKey.MatchString(key) {
+		return "", "", false
+	}
+
+	value = strings.TrimSpace(value)
+
+	switch {
+	case strings.HasPrefix(value, `"`):
+		var b strings.Builder
+
+		for i := 1; i < len(value); i++ {
+			c := value[i]
+
+			if c == '"' {
+				break
+			}
+
+			if c == '\\' && i+1 < len(value) {
+				i++
+				c = value[i]
+
+				if c == 'n' {
+					c = '\n'
+				}
+			}
+
+			b.WriteByte(c)
+		}
+
+		value = b.String()
+	case strings.HasPrefix(value, "'"):
+		value = value[1:]
+		if i := strings.Index(value, "'"); i >= 0 {
+			value = value[:i]
+		}
+	default:
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+	}
+
+	return key, value, true
+}
+
+// renderEnv returns a .env file setting the variables, sorted by name.
+func renderEnv(variables map[string]string) string {
+	var b strings.Builder
+
+	for _, key := range sortedKeys(variables) {
+		b.WriteString(envLine(key, variables[key]))
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// mergeEnv sets the variables in the .env file content, in place where the
+// file already sets them, and removes the variables in remove. Other lines
+// are kept as they are.
+func mergeEnv(content string, variables map[string]string, remove []string) string {
+	written := map[string]bool{}
+
+	var lines []string
+
+	for _, line := range envLines(strings.TrimRight(content, "\n")) {
+		key, _, ok := parseEnvLine(line)
+
+		switch value, set := variables[key]; {
+		case ok && set:
+			if !written[key] {
+				lines = append(lines, envLine(key, value))
+				written[key] = true
+			}
+		case ok && contains(remove, key):
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) == 1 && lines[0] == "" {
+		lines = nil
+	}
+
+	var added []string
+
+	for key := range variables {
+		if !written[key] {
+			added = append(added, key)
+		}
+	}
+
+	sort.Strings(added)
+
+	for _, key := range added {
+		lines = append(lines, envLine(key, variables[key]))
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// envLine returns the line setting a variable, quoting the value where
+// needed. Single quotes keep values such as ${APP_NAME} as they are.
+func envLine(key, value string) string {
+	switch {
+	case envBareValue.MatchString(value):
+	case !strings.ContainsAny(value, "'\n"):
+		value = "'" + value + "'"
+	default:
+		value = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", `\$`).Replace(value) + `"`
+	}
+
+	return key + "=" + value
+}
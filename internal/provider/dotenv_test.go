@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+	}{
+		{
+			name:    "bare values",
+			content: "APP_NAME=Forge\nAPP_URL=https://forge.test\n",
+			want:    map[string]string{"APP_NAME": "Forge", "APP_URL": "https://forge.test"},
+		},
+		{
+			name:    "empty value",
+			content: "MAIL_PASSWORD=\n",
+			want:    map[string]string{"MAIL_PASSWORD": ""},
+		},
+		{
+			name:    "comments and blank lines",
+			content: "# Application\n\nAPP_NAME=Forge # the name\n  # indented comment\nAPP_ENV=production#not-a-comment\n",
+			want:    map[string]string{"APP_NAME": "Forge", "APP_ENV": "production#not-a-comment"},
+		},
+		{
+			name:    "double quotes",
+			content: `APP_NAME="My App" # comment` + "\n" + `QUOTE="say \"hi\""` + "\n" + `PATH_VAR="C:\\forge"`,
+			want:    map[string]string{"APP_NAME": "My App", "QUOTE": `say "hi"`, "PATH_VAR": `C:\forge`},
+		},
+		{
+			name:    "single quotes keep their content",
+			content: `APP_NAME='${APP} \n "x"' # comment`,
+			want:    map[string]string{"APP_NAME": `${APP} \n "x"`},
+		},
+		{
+			name:    "export prefix",
+			content: "export APP_NAME=Forge\nexport  DB_HOST = 127.0.0.1\n",
+			want:    map[string]string{"APP_NAME": "Forge", "DB_HOST": "127.0.0.1"},
+		},
+		{
+			name:    "spaces around the equals sign",
+			content: "APP_NAME = Forge\n",
+			want:    map[string]string{"APP_NAME": "Forge"},
+		},
+		{
+			name:    "windows line endings",
+			content: "APP_NAME=Forge\r\nAPP_ENV=local\r\n",
+			want:    map[string]string{"APP_NAME": "Forge", "APP_ENV": "local"},
+		},
+		{
+			name:    "escaped newlines",
+			content: `KEY="line 1\nline 2"`,
+			want:    map[string]string{"KEY": "line 1\nline 2"},
+		},
+		{
+			name:    "multiline double quoted value",
+			content: "KEY=\"-----BEGIN KEY-----\nabc==\n-----END KEY-----\"\nAPP_NAME=Forge\n",
+			want:    map[string]string{"KEY": "-----BEGIN KEY-----\nabc==\n-----END KEY-----", "APP_NAME": "Forge"},
+		},
+		{
+			name:    "multiline single quoted value",
+			content: "KEY='a\nB=c'\nAPP_NAME=Forge\n",
+			want:    map[string]string{"KEY": "a\nB=c", "APP_NAME": "Forge"},
+		},
+		{
+			name:    "escaped quote does not close a multiline value",
+			content: "KEY=\"a \\\"\nb\"\nAPP_NAME=Forge\n",
+			want:    map[string]string{"KEY": "a \"\nb", "APP_NAME": "Forge"},
+		},
+		{
+			name:    "invalid lines",
+			content: "not a variable\n1BAD=x\n=x\nGOOD=y\n",
+			want:    map[string]string{"GOOD": "y"},
+		},
+		{
+			name:    "last value wins",
+			content: "APP_ENV=local\nAPP_ENV=production\n",
+			want:    map[string]string{"APP_ENV": "production"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseEnv(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseEnv(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvLine(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "Forge", want: "KEY=Forge"},
+		{value: "", want: "KEY="},
+		{value: "https://forge.test/path", want: "KEY=https://forge.test/path"},
+		{value: "My App", want: "KEY='My App'"},
+		{value: "${APP_NAME}", want: "KEY='${APP_NAME}'"},
+		{value: `say "hi"`, want: `KEY='say "hi"'`},
+		{value: "it's", want: `KEY="it's"`},
+		{value: "a\nb", want: `KEY="a\nb"`},
+		{value: `it's $5 \ "x"`, want: `KEY="it's \$5 \\ \"x\""`},
+	}
+
+	for _, tt := range tests {
+		if got := envLine("KEY", tt.value); got != tt.want {
+			t.Errorf("envLine(KEY, %q) = %s, want %s", tt.value, got, tt.want)
+		}
+
+		if got := parseEnv(envLine("KEY", tt.value))["KEY"]; got != tt.value {
+			t.Errorf("parseEnv(envLine(KEY, %q)) = %q, want the value back", tt.value, got)
+		}
+	}
+}
+
+func TestRenderEnv(t *testing.T) {
+	got := renderEnv(map[string]string{"B": "two words", "A": "1"})
+
+	if want := "A=1\nB='two words'\n"; got != want {
+		t.Errorf("renderEnv() = %q, want %q", got, want)
+	}
+}
+
+func TestMergeEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		variables map[string]string
+		remove    []string
+		want      string
+	}{
+		{
+			name:      "updates in place and keeps other lines",
+			content:   "# App\nAPP_NAME=Forge\n\nexport DB_HOST=localhost # local\nAPP_ENV=local\n",
+			variables: map[string]string{"DB_HOST": "db.internal"},
+			want:      "# App\nAPP_NAME=Forge\n\nDB_HOST=db.internal\nAPP_ENV=local\n",
+		},
+		{
+			name:      "appends new variables sorted",
+			content:   "APP_NAME=Forge\n",
+			variables: map[string]string{"Z": "1", "B": "2"},
+			want:      "APP_NAME=Forge\nB=2\nZ=1\n",
+		},
+		{
+			name:      "empty file",
+			content:   "",
+			variables: map[string]string{"A": "1"},
+			want:      "A=1\n",
+		},
+		{
+			name:      "removes variables",
+			content:   "A=1\nOLD=2\nB=3\n",
+			variables: map[string]string{},
+			remove:    []string{"OLD"},
+			want:      "A=1\nB=3\n",
+		},
+		{
+			name:      "drops duplicates of a set variable",
+			content:   "A=1\nA=2\n",
+			variables: map[string]string{"A": "3"},
+			want:      "A=3\n",
+		},
+		{
+			name:      "keeps comments that mention a variable",
+			content:   "# A=1\nA=2\n",
+			variables: map[string]string{"A": "3"},
+			want:      "# A=1\nA=3\n",
+		},
+		{
+			name:      "replaces multiline values as a whole",
+			content:   "KEY=\"-----BEGIN KEY-----\nabc==\n-----END KEY-----\"\nAPP_NAME=Forge\n",
+			variables: map[string]string{"KEY": "new"},
+			want:      "KEY=new\nAPP_NAME=Forge\n",
+		},
+		{
+			name:      "keeps multiline values of other variables",
+			content:   "KEY=\"a\nb\"\nAPP_NAME=Forge\n",
+			variables: map[string]string{"APP_NAME": "Other"},
+			want:      "KEY=\"a\nb\"\nAPP_NAME=Other\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeEnv(tt.content, tt.variables, tt.remove); got != tt.want {
+				t.Errorf("mergeEnv() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
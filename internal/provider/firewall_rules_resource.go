@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_firewall_rules"
+)
+
+// NewFirewallRulesResource returns the laravelforge_firewall_rules resource,
+// which manages a firewall rule of a server.
+func NewFirewallRulesResource() resource.Resource {
+	return &apiResource{
+		name:   "firewall_rules",
+		schema: resource_firewall_rules.FirewallRulesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/firewall-rules"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/firewall-rules/{rule}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/firewall-rules/{rule}"},
+	}
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_heartbeats"
+)
+
+// NewHeartbeatsResource returns the laravelforge_heartbeats resource, which
+// manages a heartbeat of a site.
+func NewHeartbeatsResource() resource.Resource {
+	return &apiResource{
+		name:   "heartbeats",
+		schema: resource_heartbeats.HeartbeatsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/heartbeats"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/heartbeats/{heartbeat}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/heartbeats/{heartbeat}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/heartbeats/{heartbeat}"},
+	}
+}
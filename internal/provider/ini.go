@@ -0,0 +1,31 @@
+package provider
+
+import "strings"
+
+// iniLines returns the sections and key=value lines of an ini file, with
+// comments, blank lines and the spacing around = left out.
+func iniLines(content string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, ";") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if key, value, ok := strings.Cut(line, "="); ok {
+			line = strings.TrimSpace(key) + "=" + strings.TrimSpace(value)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// sameINI reports whether two ini files set the same values in the same
+// sections.
+func sameINI(a, b string) bool {
+	return strings.Join(iniLines(a), "\n") == strings.Join(iniLines(b), "\n")
+}
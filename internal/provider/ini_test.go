@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestINILines(t *testing.T) {
+	content := "; PHP settings\n[PHP]\n\n  memory_limit = 512M\n# comment\nerror_reporting=E_ALL & ~E_DEPRECATED\n[opcache]\nopcache.enable =1\r\n"
+
+	want := []string{"[PHP]", "memory_limit=512M", "error_reporting=E_ALL & ~E_DEPRECATED", "[opcache]", "opcache.enable=1"}
+
+	if got := iniLines(content); !reflect.DeepEqual(got, want) {
+		t.Errorf("iniLines() = %q, want %q", got, want)
+	}
+}
+
+func TestSameINI(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{
+			name: "identical",
+			a:    "[PHP]\nmemory_limit=512M\n",
+			b:    "[PHP]\nmemory_limit=512M\n",
+			want: true,
+		},
+		{
+			name: "spacing around equals",
+			a:    "memory_limit = 512M\n",
+			b:    "memory_limit=512M",
+			want: true,
+		},
+		{
+			name: "comments and blank lines",
+			a:    "; Managed by Terraform\n\n[PHP]\n# limit\nmemory_limit=512M\n\n",
+			b:    "[PHP]\nmemory_limit=512M",
+			want: true,
+		},
+		{
+			name: "indentation and line endings",
+			a:    "[PHP]\r\n    memory_limit=512M\r\n",
+			b:    "[PHP]\nmemory_limit=512M\n",
+			want: true,
+		},
+		{
+			name: "different value",
+			a:    "memory_limit=512M\n",
+			b:    "memory_limit=256M\n",
+			want: false,
+		},
+		{
+			name: "spacing inside a value",
+			a:    "error_reporting=E_ALL & ~E_NOTICE\n",
+			b:    "error_reporting=E_ALL&~E_NOTICE\n",
+			want: false,
+		},
+		{
+			name: "different section",
+			a:    "[PHP]\nmemory_limit=512M\n",
+			b:    "[CLI Server]\nmemory_limit=512M\n",
+			want: false,
+		},
+		{
+			name: "different order",
+			a:    "memory_limit=512M\nmax_input_vars=1000\n",
+			b:    "max_input_vars=1000\nmemory_limit=512M\n",
+			want: false,
+		},
+		{
+			name: "added setting",
+			a:    "memory_limit=512M\n",
+			b:    "memory_limit=512M\nmax_input_vars=1000\n",
+			want: false,
+		},
+		{
+			name: "commented out setting",
+			a:    "memory_limit=512M\n",
+			b:    ";memory_limit=512M\n",
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameINI(tt.a, tt.b); got != tt.want {
+				t.Errorf("sameINI(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
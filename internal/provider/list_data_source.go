@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+var (
+	_ datasource.DataSource              = &listDataSource{}
+	_ datasource.DataSourceWithConfigure = &listDataSource{}
+)
+
+// listDataSource implements a data source on top of the schema generated
+// for a list endpoint of the API. The path parameters map onto attributes
+// of the same name in snake case, with organization defaulting to the
+// organization of the provider. All pages are read into the list attribute,
+// each element holding the id and the attributes of a JSON:API resource.
+type listDataSource struct {
+	// name is the data source type name without the provider prefix.
+	name   string
+	schema func(context.Context) schema.Schema
+	path   string
+
+	// list is the attribute holding the resources.
+	list string
+
+	// filters maps attributes to the query parameters they filter on.
+	filters map[string]string
+
+	typeName string
+	client   *forge.Client
+}
+
+func (d *listDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	d.typeName = req.ProviderTypeName + "_" + d.name
+	resp.TypeName = d.typeName
+}
+
+func (d *listDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = d.schema(ctx)
+}
+
+func (d *listDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// The framework calls Metadata on another instance, so the type name
+	// used in diagnostics is set here.
+	d.typeName = providerTypeName + "_" + d.name
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*forge.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *forge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *listDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	values, err := objectValues(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	listPath, ok := d.requestPath(values, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	query := url.Values{}
+
+	for name, param := range d.filters {
+		if v := values[name]; isSet(v) {
+			value, err := paramValue(v)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid value", err.Error())
+
+				return
+			}
+
+			query.Set(param, value)
+		}
+	}
+
+	resources, err := d.client.ListAll(ctx, listPath, query)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read "+d.typeName, err.Error())
+
+		return
+	}
+
+	objectType, _ := req.Config.Raw.Type().(tftypes.Object)
+	listType, _ := objectType.AttributeTypes[d.list].(tftypes.List)
+	elementType, _ := listType.ElementType.(tftypes.Object)
+
+	elements := make([]tftypes.Value, 0, len(resources))
+
+	for _, resource := range resources {
+		element, err := resourceValue(elementType, resource)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(d.list), "Unexpected Laravel Forge API response", err.Error())
+
+			return
+		}
+
+		elements = append(elements, element)
+	}
+
+	values[d.list] = tftypes.NewValue(listType, elements)
+
+	resp.State.Raw = tftypes.NewValue(objectType, values)
+}
+
+// requestPath returns the path of the data source with the parameters in
+// values, setting the organization in values to the one of the provider when
+// it is not set.
+func (d *listDataSource) requestPath(values map[string]tftypes.Value, diags *diag.Diagnostics) (string, bool) {
+	if !isSet(values["organization"]) && d.client.Organization() != "" {
+		values["organization"] = tftypes.NewValue(tftypes.String, d.client.Organization())
+	}
+
+	params := map[string]string{}
+
+	for _, param := range forge.PathParameters(d.path) {
+		if v := values[snakeCase(param)]; isSet(v) {
+			var err error
+			if params[param], err = paramValue(v); err != nil {
+				diags.AddAttributeError(path.Root(snakeCase(param)), "Invalid value", err.Error())
+
+				return "", false
+			}
+		}
+	}
+
+	requestPath, err := forge.ExpandPath(d.path, params)
+	if err != nil {
+		diags.AddError("Unable to read "+d.typeName, err.Error())
+
+		return "", false
+	}
+
+	return requestPath, true
+}
+
+// resourceValue converts a JSON:API resource into an object holding its id
+// and attributes.
+func resourceValue(typ tftypes.Object, resource forge.Resource) (tftypes.Value, error) {
+	attributes := map[string]any{}
+	for name, value := range resource.Attributes {
+		attributes[name] = value
+	}
+
+	attributes["id"] = resource.ID
+
+	value, err := fromJSON(typ, attributes)
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("%s %s: %w", resource.Type, resource.ID, err)
+	}
+
+	return value, nil
+}
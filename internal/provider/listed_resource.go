@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+// listedResource implements resources the API can only list, such as the
+// servers shared with a team. The read operation lists the resources, and
+// the resource is the one with the id of the delete path parameter, which
+// the attribute it maps onto is set to when creating the resource.
+type listedResource struct {
+	*apiResource
+}
+
+// Read removes the resource from the state when the list does not have it.
+// The attributes in the state are not refreshed.
+func (r *listedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	listPath, err := r.path(r.read, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	var id string
+
+	if ids := r.ids(); len(ids) > 0 {
+		if id, err = paramValue(state[ids[0]]); err != nil {
+			resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+			return
+		}
+	}
+
+	resources, err := r.client.ListAll(ctx, listPath, nil)
+	if err != nil {
+		if forge.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	for _, resource := range resources {
+		if resource.ID == id {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
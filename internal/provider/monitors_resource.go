@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_monitors"
+)
+
+// NewMonitorsResource returns the laravelforge_monitors resource, which
+// manages a resource monitor of a server.
+func NewMonitorsResource() resource.Resource {
+	return &apiResource{
+		name:   "monitors",
+		schema: resource_monitors.MonitorsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/monitors"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/monitors/{monitor}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/monitors/{monitor}"},
+	}
+}
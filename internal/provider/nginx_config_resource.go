@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ResourceWithValidateConfig = &nginxConfigResource{}
+
+// nginxConfigResource manages an nginx configuration file, which Laravel
+// Forge reformats and reloads nginx for. The content is computed so that the
+// plan keeps the value in the state when the configuration only changes its
+// whitespace, and is required by ValidateConfig instead of the schema.
+type nginxConfigResource struct {
+	*apiResource
+}
+
+func (r *nginxConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config, err := objectValues(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	if config["content"].IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Missing required argument", `The argument "content" is required, but no definition was found.`)
+	}
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_nginx_templates"
+)
+
+// NewNginxTemplatesResource returns the laravelforge_nginx_templates
+// resource, which manages an Nginx template of a server.
+func NewNginxTemplatesResource() resource.Resource {
+	return &apiResource{
+		name:   "nginx_templates",
+		schema: resource_nginx_templates.NginxTemplatesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/nginx/templates"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/nginx/templates/{nginxTemplate}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/nginx/templates/{nginxTemplate}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/nginx/templates/{nginxTemplate}"},
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// pemCertificates parses a certificate chain of PEM encoded certificates,
+// starting with the certificate of the domain.
+func pemCertificates(chain string) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	rest := []byte(chain)
+
+	for {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			return nil, fmt.Errorf("expected CERTIFICATE PEM blocks, got %s", block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d: %w", len(certificates)+1, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("unexpected content after the last PEM block")
+	}
+
+	return certificates, nil
+}
+
+// pemPrivateKey parses a PEM encoded PKCS #1, PKCS #8 or EC private key and
+// returns its public key.
+func pemPrivateKey(key string) (crypto.PublicKey, error) {
+	block, rest := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("expected a single PEM block")
+	}
+
+	// The key is stored in the state and sent to Laravel Forge as is, which
+	// has no passphrase to decrypt it with.
+	if block.Type == "ENCRYPTED PRIVATE KEY" || strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED") {
+		return nil, errors.New("the private key is encrypted, decrypt it first")
+	}
+
+	var (
+		private any
+		err     error
+	)
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("expected a PRIVATE KEY, RSA PRIVATE KEY or EC PRIVATE KEY PEM block, got %s", block.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", private)
+	}
+
+	return signer.Public(), nil
+}
+
+// samePublicKey reports whether two public keys are equal.
+func samePublicKey(a, b crypto.PublicKey) bool {
+	key, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+
+	return ok && key.Equal(b)
+}
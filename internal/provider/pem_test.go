@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCertificate returns a PEM encoded certificate for the public key of
+// key, signed by key itself.
+func testCertificate(t *testing.T, name string, key crypto.Signer) string {
+	t.Helper()
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testECKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func TestPEMCertificates(t *testing.T) {
+	leaf := testCertificate(t, "example.com", testECKey(t))
+	intermediate := testCertificate(t, "Intermediate CA", testECKey(t))
+
+	tests := []struct {
+		name    string
+		chain   string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "single certificate",
+			chain: leaf,
+			want:  []string{"example.com"},
+		},
+		{
+			name:  "chain",
+			chain: leaf + intermediate,
+			want:  []string{"example.com", "Intermediate CA"},
+		},
+		{
+			name:  "whitespace around blocks",
+			chain: "\n" + leaf + "\n\n" + intermediate + "\n",
+			want:  []string{"example.com", "Intermediate CA"},
+		},
+		{
+			name:    "garbage",
+			chain:   "not a certificate",
+			wantErr: "no PEM encoded certificate found",
+		},
+		{
+			name:    "empty",
+			chain:   "",
+			wantErr: "no PEM encoded certificate found",
+		},
+		{
+			name:    "content after the last block",
+			chain:   leaf + "garbage",
+			wantErr: "unexpected content after the last PEM block",
+		},
+		{
+			name:    "private key instead of a certificate",
+			chain:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte{1}})),
+			wantErr: "expected CERTIFICATE PEM blocks, got PRIVATE KEY",
+		},
+		{
+			name:    "invalid certificate in the chain",
+			chain:   leaf + string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")})),
+			wantErr: "certificate 2: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certificates, err := pemCertificates(tt.chain)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("pemCertificates() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("pemCertificates() error = %v", err)
+			}
+
+			var names []string
+			for _, certificate := range certificates {
+				names = append(names, certificate.Subject.CommonName)
+			}
+
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("pemCertificates() = %q, want %q", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestPEMPrivateKey(t *testing.T) {
+	ecKey := testECKey(t)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encode := func(typ string, der []byte, headers map[string]string) string {
+		return string(pem.EncodeToMemory(&pem.Block{Type: typ, Headers: headers, Bytes: der}))
+	}
+
+	pkcs8 := func(key any) []byte {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return der
+	}
+
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		key     string
+		want    crypto.PublicKey
+		wantErr string
+	}{
+		{
+			name: "PKCS #8 RSA key",
+			key:  encode("PRIVATE KEY", pkcs8(rsaKey), nil),
+			want: rsaKey.Public(),
+		},
+		{
+			name: "PKCS #8 EC key",
+			key:  encode("PRIVATE KEY", pkcs8(ecKey), nil),
+			want: ecKey.Public(),
+		},
+		{
+			name: "PKCS #8 Ed25519 key",
+			key:  encode("PRIVATE KEY", pkcs8(edKey), nil),
+			want: edKey.Public(),
+		},
+		{
+			name: "PKCS #1 RSA key",
+			key:  encode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey), nil),
+			want: rsaKey.Public(),
+		},
+		{
+			name: "EC key",
+			key:  encode("EC PRIVATE KEY", ecDER, nil),
+			want: ecKey.Public(),
+		},
+		{
+			name:    "encrypted PKCS #8 key",
+			key:     encode("ENCRYPTED PRIVATE KEY", []byte("encrypted"), nil),
+			wantErr: "the private key is encrypted",
+		},
+		{
+			name:    "encrypted PKCS #1 key",
+			key:     encode("RSA PRIVATE KEY", []byte("encrypted"), map[string]string{"Proc-Type": "4,ENCRYPTED", "DEK-Info": "AES-256-CBC,00000000000000000000000000000000"}),
+			wantErr: "the private key is encrypted",
+		},
+		{
+			name:    "garbage",
+			key:     "not a key",
+			wantErr: "no PEM encoded private key found",
+		},
+		{
+			name:    "certificate instead of a key",
+			key:     testCertificate(t, "example.com", ecKey),
+			wantErr: "got CERTIFICATE",
+		},
+		{
+			name:    "several blocks",
+			key:     encode("EC PRIVATE KEY", ecDER, nil) + encode("EC PRIVATE KEY", ecDER, nil),
+			wantErr: "expected a single PEM block",
+		},
+		{
+			name:    "invalid key",
+			key:     encode("EC PRIVATE KEY", []byte("garbage"), nil),
+			wantErr: "x509: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pemPrivateKey(tt.key)
+
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("pemPrivateKey() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("pemPrivateKey() error = %v", err)
+			}
+
+			if !samePublicKey(got, tt.want) {
+				t.Errorf("pemPrivateKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSamePublicKey(t *testing.T) {
+	key := testECKey(t)
+	other := testECKey(t)
+
+	certificates, err := pemCertificates(testCertificate(t, "example.com", key))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !samePublicKey(certificates[0].PublicKey, key.Public()) {
+		t.Error("samePublicKey() = false for the key of the certificate, want true")
+	}
+
+	if samePublicKey(certificates[0].PublicKey, other.Public()) {
+		t.Error("samePublicKey() = true for a mismatched key, want false")
+	}
+
+	if samePublicKey(nil, key.Public()) {
+		t.Error("samePublicKey() = true for a nil key, want false")
+	}
+}
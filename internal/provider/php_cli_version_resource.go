@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_cli_version"
+)
+
+// NewPhpCliVersionResource returns the laravelforge_php_cli_version
+// resource, which sets the PHP version of the php command of a server.
+// Creating it updates the setting, or adopts the current one when the
+// version is not set. Destroying it leaves the setting as it is.
+func NewPhpCliVersionResource() resource.Resource {
+	return &phpSettingResource{&apiResource{
+		name:   "php_cli_version",
+		schema: resource_php_cli_version.PhpCliVersionResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/cli-version"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/cli-version"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/cli-version"},
+
+		bodyFields: map[string]string{"version": "php_version"},
+	}, "version"}
+}
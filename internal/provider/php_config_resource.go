@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_config"
+)
+
+// NewPhpConfigResource returns the laravelforge_php_config resource, which
+// manages the fpm, cli or pool ini file of a PHP version, as picked by the
+// kind attribute.
+func NewPhpConfigResource() resource.Resource {
+	return &apiResource{
+		name:   "php_config",
+		schema: resource_php_config.PhpConfigResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/versions/{phpVersion}/configs/{kind}"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/versions/{phpVersion}/configs/{kind}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/versions/{phpVersion}/configs/{kind}"},
+
+		fields:     map[string]string{"content": "configuration"},
+		bodyFields: map[string]string{"content": "config"},
+		equal:      map[string]func(string, string) bool{"content": sameINI},
+	}
+}
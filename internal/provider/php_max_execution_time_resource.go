@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_max_execution_time"
+)
+
+// NewPhpMaxExecutionTimeResource returns the
+// laravelforge_php_max_execution_time resource, which sets the maximum
+// execution time of PHP on a server. Creating it updates the setting, or
+// adopts the current one when max_execution_time is not set. Destroying it
+// leaves the setting as it is.
+func NewPhpMaxExecutionTimeResource() resource.Resource {
+	return &phpSettingResource{&apiResource{
+		name:   "php_max_execution_time",
+		schema: resource_php_max_execution_time.PhpMaxExecutionTimeResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/max-execution-time"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/max-execution-time"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/max-execution-time"},
+	}, "max_execution_time"}
+}
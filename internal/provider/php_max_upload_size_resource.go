@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_max_upload_size"
+)
+
+// NewPhpMaxUploadSizeResource returns the laravelforge_php_max_upload_size
+// resource, which sets the maximum upload size of PHP on a server. Creating
+// it updates the setting, or adopts the current one when max_upload_size is
+// not set. Destroying it leaves the setting as it is.
+func NewPhpMaxUploadSizeResource() resource.Resource {
+	return &phpSettingResource{&apiResource{
+		name:   "php_max_upload_size",
+		schema: resource_php_max_upload_size.PhpMaxUploadSizeResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/max-upload-size"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/max-upload-size"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/max-upload-size"},
+	}, "max_upload_size"}
+}
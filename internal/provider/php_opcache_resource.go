@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_opcache"
+)
+
+// NewPhpOpcacheResource returns the laravelforge_php_opcache resource, which
+// enables PHP OPcache on a server.
+func NewPhpOpcacheResource() resource.Resource {
+	return &apiResource{
+		name:   "php_opcache",
+		schema: resource_php_opcache.PhpOpcacheResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/php/opcache"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/opcache"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/php/opcache"},
+
+		jsonAPIType: "phpOpcaches",
+	}
+}
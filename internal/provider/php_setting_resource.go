@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// phpSettingResource manages a PHP setting of a server, which always exists.
+// When the setting is not configured, creating the resource adopts the
+// current value of the server instead of updating it.
+type phpSettingResource struct {
+	*apiResource
+
+	// setting is the attribute of the value of the setting.
+	setting string
+}
+
+func (r *phpSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	if isSet(plan[r.setting]) {
+		r.apiResource.Create(ctx, req, resp)
+
+		return
+	}
+
+	data, ok := r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+}
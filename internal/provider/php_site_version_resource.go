@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_site_version"
+)
+
+// NewPhpSiteVersionResource returns the laravelforge_php_site_version
+// resource, which sets the default PHP version of new sites on a server.
+// Creating it updates the setting, or adopts the current one when the
+// version is not set. Destroying it leaves the setting as it is.
+func NewPhpSiteVersionResource() resource.Resource {
+	return &phpSettingResource{&apiResource{
+		name:   "php_site_version",
+		schema: resource_php_site_version.PhpSiteVersionResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/site-version"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/site-version"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/php/site-version"},
+
+		bodyFields: map[string]string{"version": "php_version"},
+	}, "version"}
+}
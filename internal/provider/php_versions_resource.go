@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_php_versions"
+)
+
+// NewPhpVersionsResource returns the laravelforge_php_versions resource,
+// which manages a PHP version installed on a server.
+func NewPhpVersionsResource() resource.Resource {
+	return &apiResource{
+		name:   "php_versions",
+		schema: resource_php_versions.PhpVersionsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/php/versions"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/php/versions/{phpVersion}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/php/versions/{phpVersion}"},
+		// The update operation only upgrades the installed version to the
+		// latest patch release, so every change replaces the resource.
+	}
+}
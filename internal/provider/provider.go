@@ -2,17 +2,38 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/provider_laravelforge"
+)
+
+// DefaultBaseURL is the Laravel Forge API server listed in generator/docs.openapi.json.
+const DefaultBaseURL = "https://forge.laravel.com/api"
+
+// Environment variables consulted when an attribute is not set in the provider block.
+const (
+	EnvAPIToken     = "FORGE_API_TOKEN"
+	EnvOrganization = "FORGE_ORGANIZATION"
+	EnvBaseURL      = "FORGE_BASE_URL"
 )
 
 type LaravelforgeProvider struct {
 	version string
 }
 
+// providerTypeName prefixes the type names of resources and data sources.
+const providerTypeName = "laravelforge"
+
 // New returns a new provider instance.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -21,22 +42,227 @@ func New(version string) func() provider.Provider {
 }
 
 func (p *LaravelforgeProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "laravelforge"
+	resp.TypeName = providerTypeName
 	resp.Version = p.version
 }
 
-func (p *LaravelforgeProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-	resp.Schema = schema.Schema{}
+func (p *LaravelforgeProvider) Schema(ctx context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = provider_laravelforge.LaravelforgeProviderSchema(ctx)
 }
 
-func (p *LaravelforgeProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
-	// No-op: add client initialization here when available.
+func (p *LaravelforgeProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data provider_laravelforge.LaravelforgeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := resolveConfig(data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := forge.NewClient(forge.Config{
+		BaseURL:      config.BaseURL,
+		Token:        config.APIToken,
+		Organization: config.Organization,
+		UserAgent:    "terraform-provider-laravelforge/" + p.version,
+
+		MaxRequestsPerMinute: config.MaxRequestsPerMinute,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create Laravel Forge API client", err.Error())
+
+		return
+	}
+
+	user, err := client.Me(ctx)
+	if forge.IsUnauthorized(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Invalid Laravel Forge API token",
+			"The Laravel Forge API rejected the API token. Check that the token is correct and has not been revoked or expired.\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	if forge.IsForbidden(err) {
+		resp.Diagnostics.AddWarning(
+			"Unable to verify Laravel Forge API token",
+			"The API token is valid but is not allowed to read the authenticated user, which requires the user:view scope. "+
+				"Requests will still be made with this token.\n\n"+err.Error(),
+		)
+	} else if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to verify Laravel Forge API token",
+			"The provider could not reach the Laravel Forge API at "+config.BaseURL+" to verify the API token.\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	if user != nil {
+		tflog.Debug(ctx, "Authenticated with Laravel Forge", map[string]any{"user_id": user.ID})
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
 }
 
 func (p *LaravelforgeProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		NewBackgroundProcessesResource,
+		NewComposerCredentialsResource,
+		NewDatabaseSchemasResource,
+		NewDatabaseUsersResource,
+		NewDeploymentWebhooksResource,
+		NewDeploymentsResource,
+		NewDomainActionResource,
+		NewDomainCertificateActionResource,
+		NewDomainCertificatesResource,
+		NewDomainNginxConfigResource,
+		NewFirewallRulesResource,
+		NewHeartbeatsResource,
+		NewMonitorsResource,
+		NewNginxTemplatesResource,
+		NewPhpCliVersionResource,
+		NewPhpConfigResource,
+		NewPhpMaxExecutionTimeResource,
+		NewPhpMaxUploadSizeResource,
+		NewPhpOpcacheResource,
+		NewPhpSiteVersionResource,
+		NewPhpVersionsResource,
+		NewRecipeRunsResource,
+		NewRecipesResource,
+		NewRedirectRulesResource,
+		NewRegionVpcsResource,
+		NewRolesResource,
+		NewSecurityRulesResource,
+		NewServerScheduledJobsResource,
+		NewServerServiceActionResource,
+		NewServersResource,
+		NewSiteCommandsResource,
+		NewSiteDeployHookResource,
+		NewSiteDeploymentScriptResource,
+		NewSiteDomainsResource,
+		NewSiteEnvironmentResource,
+		NewSiteHealthcheckResource,
+		NewSiteHorizonIntegrationResource,
+		NewSiteInertiaIntegrationResource,
+		NewSiteLaravelMaintenanceIntegrationResource,
+		NewSiteLaravelSchedulerIntegrationResource,
+		NewSiteLoadBalancingResource,
+		NewSiteNginxConfigResource,
+		NewSiteOctaneIntegrationResource,
+		NewSitePulseIntegrationResource,
+		NewSitePushToDeployResource,
+		NewSiteReverbIntegrationResource,
+		NewSiteScheduledJobsResource,
+		NewSitesResource,
+		NewSshKeysResource,
+		NewTeamInvitesResource,
+		NewTeamMembersResource,
+		NewTeamRecipesResource,
+		NewTeamServerCredentialsResource,
+		NewTeamServersResource,
+		NewTeamsResource,
+	}
 }
 
 func (p *LaravelforgeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewBackgroundProcessLogDataSource,
+		NewServersDataSource,
+	}
+}
+
+// providerConfig is the provider configuration after applying environment
+// variables and defaults.
+type providerConfig struct {
+	APIToken             string
+	Organization         string
+	BaseURL              string
+	MaxRequestsPerMinute int
+}
+
+// resolveConfig fills in unset attributes from the environment and
+// validates the result.
+func resolveConfig(data provider_laravelforge.LaravelforgeModel, diags *diag.Diagnostics) providerConfig {
+	config := providerConfig{
+		APIToken:     configValue(data.ApiToken, path.Root("api_token"), EnvAPIToken, diags),
+		Organization: configValue(data.Organization, path.Root("organization"), EnvOrganization, diags),
+		BaseURL:      configValue(data.BaseUrl, path.Root("base_url"), EnvBaseURL, diags),
+	}
+
+	if diags.HasError() {
+		return config
+	}
+
+	if config.APIToken == "" {
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Missing Laravel Forge API token",
+			"The provider cannot create the Laravel Forge API client because the API token is missing. "+
+				"Set the api_token attribute in the provider configuration or the "+EnvAPIToken+" environment variable. "+
+				"Tokens can be created in the Laravel Forge dashboard under your profile's API settings.",
+		)
+	}
+
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultBaseURL
+	}
+
+	switch {
+	case data.MaxRequestsPerMinute.IsUnknown():
+		diags.AddAttributeError(
+			path.Root("max_requests_per_minute"),
+			"Unknown provider configuration value",
+			"The provider cannot be configured with a value that is only known after apply.",
+		)
+	case data.MaxRequestsPerMinute.IsNull():
+		config.MaxRequestsPerMinute = forge.DefaultMaxRequestsPerMinute
+	case data.MaxRequestsPerMinute.ValueInt64() < 1:
+		diags.AddAttributeError(
+			path.Root("max_requests_per_minute"),
+			"Invalid maximum requests per minute",
+			fmt.Sprintf("The maximum number of requests per minute must be at least 1, got: %d", data.MaxRequestsPerMinute.ValueInt64()),
+		)
+	default:
+		config.MaxRequestsPerMinute = int(data.MaxRequestsPerMinute.ValueInt64())
+	}
+
+	if u, err := url.Parse(config.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		diags.AddAttributeError(
+			path.Root("base_url"),
+			"Invalid Laravel Forge API base URL",
+			"The base URL must be an absolute http or https URL, such as "+DefaultBaseURL+", got: "+config.BaseURL,
+		)
+	}
+
+	return config
+}
+
+// configValue returns the configured value, falling back to the environment
+// variable when the attribute is not set.
+func configValue(value types.String, attribute path.Path, env string, diags *diag.Diagnostics) string {
+	if value.IsUnknown() {
+		diags.AddAttributeError(
+			attribute,
+			"Unknown provider configuration value",
+			"The provider cannot be configured with a value that is only known after apply. "+
+				"Set the value statically in the configuration or use the "+env+" environment variable instead.",
+		)
+
+		return ""
+	}
+
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
 }
@@ -4,13 +4,42 @@ package provider_laravelforge
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 )
 
 func LaravelforgeProviderSchema(ctx context.Context) schema.Schema {
-	return schema.Schema{}
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "Laravel Forge API token. Can also be set with the `FORGE_API_TOKEN` environment variable.",
+				MarkdownDescription: "Laravel Forge API token. Can also be set with the `FORGE_API_TOKEN` environment variable.",
+			},
+			"base_url": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Base URL of the Laravel Forge API. Can also be set with the `FORGE_BASE_URL` environment variable. Defaults to `https://forge.laravel.com/api`.",
+				MarkdownDescription: "Base URL of the Laravel Forge API. Can also be set with the `FORGE_BASE_URL` environment variable. Defaults to `https://forge.laravel.com/api`.",
+			},
+			"max_requests_per_minute": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Maximum number of requests sent to the Laravel Forge API per minute, shared by all resources and data sources. Throttled requests are retried. Defaults to `60`.",
+				MarkdownDescription: "Maximum number of requests sent to the Laravel Forge API per minute, shared by all resources and data sources. Throttled requests are retried. Defaults to `60`.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Slug of the organization used when a resource does not set one. Can also be set with the `FORGE_ORGANIZATION` environment variable.",
+				MarkdownDescription: "Slug of the organization used when a resource does not set one. Can also be set with the `FORGE_ORGANIZATION` environment variable.",
+			},
+		},
+	}
 }
 
 type LaravelforgeModel struct {
+	ApiToken             types.String `tfsdk:"api_token"`
+	BaseUrl              types.String `tfsdk:"base_url"`
+	MaxRequestsPerMinute types.Int64  `tfsdk:"max_requests_per_minute"`
+	Organization         types.String `tfsdk:"organization"`
 }
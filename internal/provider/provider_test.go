@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/provider_laravelforge"
+)
+
+func TestResolveConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		data provider_laravelforge.LaravelforgeModel
+		env  map[string]string
+		want providerConfig
+
+		// wantErrors are the attributes with an error, in order.
+		wantErrors []path.Path
+	}{
+		{
+			name: "configured values",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue("token"),
+				Organization:         types.StringValue("acme"),
+				BaseUrl:              types.StringValue("http://localhost:8080/api"),
+				MaxRequestsPerMinute: types.Int64Value(30),
+			},
+			env:  map[string]string{EnvAPIToken: "env-token", EnvOrganization: "env-org", EnvBaseURL: "https://env.test"},
+			want: providerConfig{APIToken: "token", Organization: "acme", BaseURL: "http://localhost:8080/api", MaxRequestsPerMinute: 30},
+		},
+		{
+			name: "environment variables",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringNull(),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			env:  map[string]string{EnvAPIToken: "env-token", EnvOrganization: "env-org", EnvBaseURL: "https://env.test/api"},
+			want: providerConfig{APIToken: "env-token", Organization: "env-org", BaseURL: "https://env.test/api", MaxRequestsPerMinute: forge.DefaultMaxRequestsPerMinute},
+		},
+		{
+			name: "defaults",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue("token"),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			want: providerConfig{APIToken: "token", BaseURL: DefaultBaseURL, MaxRequestsPerMinute: forge.DefaultMaxRequestsPerMinute},
+		},
+		{
+			name: "missing token",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringNull(),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			wantErrors: []path.Path{path.Root("api_token")},
+		},
+		{
+			name: "empty token",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue(""),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			env:        map[string]string{EnvAPIToken: "env-token"},
+			wantErrors: []path.Path{path.Root("api_token")},
+		},
+		{
+			name: "unknown values",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringUnknown(),
+				Organization:         types.StringUnknown(),
+				BaseUrl:              types.StringUnknown(),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			env:        map[string]string{EnvAPIToken: "env-token"},
+			wantErrors: []path.Path{path.Root("api_token"), path.Root("organization"), path.Root("base_url")},
+		},
+		{
+			name: "unknown maximum requests per minute",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue("token"),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Unknown(),
+			},
+			wantErrors: []path.Path{path.Root("max_requests_per_minute")},
+		},
+		{
+			name: "invalid maximum requests per minute",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue("token"),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Value(0),
+			},
+			wantErrors: []path.Path{path.Root("max_requests_per_minute")},
+		},
+		{
+			name: "relative base URL",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue("token"),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringValue("forge.laravel.com/api"),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			wantErrors: []path.Path{path.Root("base_url")},
+		},
+		{
+			name: "unsupported base URL scheme",
+			data: provider_laravelforge.LaravelforgeModel{
+				ApiToken:             types.StringValue("token"),
+				Organization:         types.StringNull(),
+				BaseUrl:              types.StringNull(),
+				MaxRequestsPerMinute: types.Int64Null(),
+			},
+			env:        map[string]string{EnvBaseURL: "ftp://forge.test"},
+			wantErrors: []path.Path{path.Root("base_url")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{EnvAPIToken, EnvOrganization, EnvBaseURL} {
+				t.Setenv(env, tt.env[env])
+			}
+
+			var diags diag.Diagnostics
+
+			got := resolveConfig(tt.data, &diags)
+
+			var errors []path.Path
+			for _, d := range diags.Errors() {
+				if d, ok := d.(diag.DiagnosticWithPath); ok {
+					errors = append(errors, d.Path())
+				}
+			}
+
+			if len(errors) != len(tt.wantErrors) || len(errors) != diags.ErrorsCount() {
+				t.Fatalf("resolveConfig() errors = %v, want errors for %v", diags.Errors(), tt.wantErrors)
+			}
+
+			for i, p := range tt.wantErrors {
+				if !errors[i].Equal(p) {
+					t.Errorf("resolveConfig() error %d is for %s, want %s", i, errors[i], p)
+				}
+			}
+
+			if len(tt.wantErrors) == 0 && got != tt.want {
+				t.Errorf("resolveConfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := []struct {
+		name    string
+		status  int
+		baseURL string
+
+		// wantSeverity is the severity of the only diagnostic, if any.
+		wantSeverity diag.Severity
+		wantPath     path.Path
+		wantClient   bool
+	}{
+		{
+			name:       "valid token",
+			status:     http.StatusOK,
+			wantClient: true,
+		},
+		{
+			name:         "rejected token",
+			status:       http.StatusUnauthorized,
+			wantSeverity: diag.SeverityError,
+			wantPath:     path.Root("api_token"),
+		},
+		{
+			name:         "token without the user:view scope",
+			status:       http.StatusForbidden,
+			wantSeverity: diag.SeverityWarning,
+			wantClient:   true,
+		},
+		{
+			name:         "unreachable API",
+			baseURL:      unreachable.URL,
+			wantSeverity: diag.SeverityError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/me" || r.Header.Get("Authorization") != "Bearer token" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"data":{"id":"1","type":"users","attributes":{"name":"Taylor"}}}`)
+			}))
+			defer server.Close()
+
+			baseURL := server.URL
+			if tt.baseURL != "" {
+				baseURL = tt.baseURL
+			}
+
+			var resp provider.ConfigureResponse
+
+			p := New("test")()
+			p.Configure(context.Background(), provider.ConfigureRequest{Config: testProviderConfig(t, p, baseURL)}, &resp)
+
+			var got []diag.Diagnostic
+			for _, d := range resp.Diagnostics {
+				if tt.wantSeverity == 0 || d.Severity() != tt.wantSeverity {
+					t.Errorf("unexpected diagnostic: %s: %s", d.Summary(), d.Detail())
+				}
+
+				got = append(got, d)
+			}
+
+			if tt.wantSeverity != 0 && len(got) != 1 {
+				t.Fatalf("got %d diagnostics, want 1", len(got))
+			}
+
+			if len(tt.wantPath.Steps()) > 0 {
+				if d, ok := got[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(tt.wantPath) {
+					t.Errorf("diagnostic %q is not for %s", got[0].Summary(), tt.wantPath)
+				}
+			}
+
+			if _, ok := resp.ResourceData.(*forge.Client); ok != tt.wantClient {
+				t.Errorf("configured client = %t, want %t", ok, tt.wantClient)
+			}
+		})
+	}
+}
+
+// testProviderConfig returns a provider configuration with an API token for
+// the Laravel Forge API at baseURL.
+func testProviderConfig(t *testing.T, p provider.Provider, baseURL string) tfsdk.Config {
+	t.Helper()
+
+	var schemaResp provider.SchemaResponse
+
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"api_token":               tftypes.NewValue(tftypes.String, "token"),
+			"base_url":                tftypes.NewValue(tftypes.String, baseURL),
+			"max_requests_per_minute": tftypes.NewValue(tftypes.Number, nil),
+			"organization":            tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_recipe_runs"
+)
+
+// NewRecipeRunsResource returns the laravelforge_recipe_runs resource, which
+// manages a run of a recipe on a list of servers. Runs cannot be deleted,
+// destroying the resource only removes it from the state.
+func NewRecipeRunsResource() resource.Resource {
+	return &apiResource{
+		name:   "recipe_runs",
+		schema: resource_recipe_runs.RecipeRunsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/recipes/{recipe}/runs"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/recipes/{recipe}/runs/{log}"},
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_recipes"
+)
+
+// NewRecipesResource returns the laravelforge_recipes resource, which manages
+// a recipe of the organization.
+func NewRecipesResource() resource.Resource {
+	return &apiResource{
+		name:   "recipes",
+		schema: resource_recipes.RecipesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/recipes"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/recipes/{recipe}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/recipes/{recipe}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/recipes/{recipe}"},
+
+		updateFields: map[string]string{
+			"name":   "name",
+			"user":   "user",
+			"script": "script",
+		},
+	}
+}
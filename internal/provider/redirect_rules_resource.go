@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_redirect_rules"
+)
+
+// NewRedirectRulesResource returns the laravelforge_redirect_rules resource,
+// which manages a redirect rule of a site.
+func NewRedirectRulesResource() resource.Resource {
+	return &apiResource{
+		name:   "redirect_rules",
+		schema: resource_redirect_rules.RedirectRulesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/redirect-rules"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/redirect-rules/{redirectRule}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/redirect-rules/{redirectRule}"},
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_region_vpcs"
+)
+
+// NewRegionVpcsResource returns the laravelforge_region_vpcs resource, which
+// manages a VPC in a region of a server provider. VPCs cannot be deleted
+// through the API, destroying the resource only removes it from the state.
+func NewRegionVpcsResource() resource.Resource {
+	return &apiResource{
+		name:   "region_vpcs",
+		schema: resource_region_vpcs.RegionVpcsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/server-credentials/{credential}/regions/{region}/vpcs"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/server-credentials/{credential}/regions/{region}/vpcs/{vpcId}"},
+	}
+}
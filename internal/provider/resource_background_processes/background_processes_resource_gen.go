@@ -4,16 +4,10 @@ package resource_background_processes
 
 import (
 	"context"
-	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
@@ -32,61 +26,10 @@ func BackgroundProcessesResourceSchema(ctx context.Context) schema.Schema {
 				Description:         "The command to run.",
 				MarkdownDescription: "The command to run.",
 			},
-			"data": schema.SingleNestedAttribute{
-				Attributes: map[string]schema.Attribute{
-					"attributes": schema.SingleNestedAttribute{
-						Attributes: map[string]schema.Attribute{
-							"command": schema.StringAttribute{
-								Computed:            true,
-								Description:         "The command that the background process is running.",
-								MarkdownDescription: "The command that the background process is running.",
-							},
-							"created_at": schema.StringAttribute{
-								Computed:            true,
-								Description:         "The date and time the background process was created.",
-								MarkdownDescription: "The date and time the background process was created.",
-							},
-							"directory": schema.StringAttribute{
-								Computed:            true,
-								Description:         "The directory that the background process is running in.",
-								MarkdownDescription: "The directory that the background process is running in.",
-							},
-							"processes": schema.Int64Attribute{
-								Computed:            true,
-								Description:         "The number of processes that the background process is running.",
-								MarkdownDescription: "The number of processes that the background process is running.",
-							},
-							"status": schema.StringAttribute{
-								Computed:            true,
-								Description:         "The status of the background process.",
-								MarkdownDescription: "The status of the background process.",
-							},
-							"user": schema.StringAttribute{
-								Computed:            true,
-								Description:         "The user that the background process is running as.",
-								MarkdownDescription: "The user that the background process is running as.",
-							},
-						},
-						CustomType: AttributesType{
-							ObjectType: types.ObjectType{
-								AttrTypes: AttributesValue{}.AttributeTypes(ctx),
-							},
-						},
-						Computed: true,
-					},
-					"id": schema.StringAttribute{
-						Computed: true,
-					},
-					"type": schema.StringAttribute{
-						Computed: true,
-					},
-				},
-				CustomType: DataType{
-					ObjectType: types.ObjectType{
-						AttrTypes: DataValue{}.AttributeTypes(ctx),
-					},
-				},
-				Computed: true,
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The date and time the background process was created.",
+				MarkdownDescription: "The date and time the background process was created.",
 			},
 			"directory": schema.StringAttribute{
 				Optional:            true,
@@ -128,6 +71,11 @@ func BackgroundProcessesResourceSchema(ctx context.Context) schema.Schema {
 					int64validator.AtLeast(0),
 				},
 			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The status of the background process.",
+				MarkdownDescription: "The status of the background process.",
+			},
 			"stopsignal": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
@@ -161,1074 +109,15 @@ func BackgroundProcessesResourceSchema(ctx context.Context) schema.Schema {
 type BackgroundProcessesModel struct {
 	BackgroundProcess types.Int64  `tfsdk:"background_process"`
 	Command           types.String `tfsdk:"command"`
-	Data              DataValue    `tfsdk:"data"`
+	CreatedAt         types.String `tfsdk:"created_at"`
 	Directory         types.String `tfsdk:"directory"`
 	Name              types.String `tfsdk:"name"`
 	Organization      types.String `tfsdk:"organization"`
 	Processes         types.Int64  `tfsdk:"processes"`
 	Server            types.Int64  `tfsdk:"server"`
 	Startsecs         types.Int64  `tfsdk:"startsecs"`
+	Status            types.String `tfsdk:"status"`
 	Stopsignal        types.String `tfsdk:"stopsignal"`
 	Stopwaitsecs      types.Int64  `tfsdk:"stopwaitsecs"`
 	User              types.String `tfsdk:"user"`
 }
-
-var _ basetypes.ObjectTypable = DataType{}
-
-type DataType struct {
-	basetypes.ObjectType
-}
-
-func (t DataType) Equal(o attr.Type) bool {
-	other, ok := o.(DataType)
-
-	if !ok {
-		return false
-	}
-
-	return t.ObjectType.Equal(other.ObjectType)
-}
-
-func (t DataType) String() string {
-	return "DataType"
-}
-
-func (t DataType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	attributes := in.Attributes()
-
-	attributesAttribute, ok := attributes["attributes"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`attributes is missing from object`)
-
-		return nil, diags
-	}
-
-	attributesVal, ok := attributesAttribute.(basetypes.ObjectValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`attributes expected to be basetypes.ObjectValue, was: %T`, attributesAttribute))
-	}
-
-	idAttribute, ok := attributes["id"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`id is missing from object`)
-
-		return nil, diags
-	}
-
-	idVal, ok := idAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
-	}
-
-	typeAttribute, ok := attributes["type"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`type is missing from object`)
-
-		return nil, diags
-	}
-
-	typeVal, ok := typeAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
-	}
-
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	return DataValue{
-		Attributes: attributesVal,
-		Id:         idVal,
-		DataType:   typeVal,
-		state:      attr.ValueStateKnown,
-	}, diags
-}
-
-func NewDataValueNull() DataValue {
-	return DataValue{
-		state: attr.ValueStateNull,
-	}
-}
-
-func NewDataValueUnknown() DataValue {
-	return DataValue{
-		state: attr.ValueStateUnknown,
-	}
-}
-
-func NewDataValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (DataValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
-	ctx := context.Background()
-
-	for name, attributeType := range attributeTypes {
-		attribute, ok := attributes[name]
-
-		if !ok {
-			diags.AddError(
-				"Missing DataValue Attribute Value",
-				"While creating a DataValue value, a missing attribute value was detected. "+
-					"A DataValue must contain values for all attributes, even if null or unknown. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("DataValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
-			)
-
-			continue
-		}
-
-		if !attributeType.Equal(attribute.Type(ctx)) {
-			diags.AddError(
-				"Invalid DataValue Attribute Type",
-				"While creating a DataValue value, an invalid attribute value was detected. "+
-					"A DataValue must use a matching attribute type for the value. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("DataValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("DataValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
-			)
-		}
-	}
-
-	for name := range attributes {
-		_, ok := attributeTypes[name]
-
-		if !ok {
-			diags.AddError(
-				"Extra DataValue Attribute Value",
-				"While creating a DataValue value, an extra attribute value was detected. "+
-					"A DataValue must not contain values beyond the expected attribute types. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra DataValue Attribute Name: %s", name),
-			)
-		}
-	}
-
-	if diags.HasError() {
-		return NewDataValueUnknown(), diags
-	}
-
-	attributesAttribute, ok := attributes["attributes"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`attributes is missing from object`)
-
-		return NewDataValueUnknown(), diags
-	}
-
-	attributesVal, ok := attributesAttribute.(basetypes.ObjectValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`attributes expected to be basetypes.ObjectValue, was: %T`, attributesAttribute))
-	}
-
-	idAttribute, ok := attributes["id"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`id is missing from object`)
-
-		return NewDataValueUnknown(), diags
-	}
-
-	idVal, ok := idAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`id expected to be basetypes.StringValue, was: %T`, idAttribute))
-	}
-
-	typeAttribute, ok := attributes["type"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`type is missing from object`)
-
-		return NewDataValueUnknown(), diags
-	}
-
-	typeVal, ok := typeAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
-	}
-
-	if diags.HasError() {
-		return NewDataValueUnknown(), diags
-	}
-
-	return DataValue{
-		Attributes: attributesVal,
-		Id:         idVal,
-		DataType:   typeVal,
-		state:      attr.ValueStateKnown,
-	}, diags
-}
-
-func NewDataValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) DataValue {
-	object, diags := NewDataValue(attributeTypes, attributes)
-
-	if diags.HasError() {
-		// This could potentially be added to the diag package.
-		diagsStrings := make([]string, 0, len(diags))
-
-		for _, diagnostic := range diags {
-			diagsStrings = append(diagsStrings, fmt.Sprintf(
-				"%s | %s | %s",
-				diagnostic.Severity(),
-				diagnostic.Summary(),
-				diagnostic.Detail()))
-		}
-
-		panic("NewDataValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
-	}
-
-	return object
-}
-
-func (t DataType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	if in.Type() == nil {
-		return NewDataValueNull(), nil
-	}
-
-	if !in.Type().Equal(t.TerraformType(ctx)) {
-		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
-	}
-
-	if !in.IsKnown() {
-		return NewDataValueUnknown(), nil
-	}
-
-	if in.IsNull() {
-		return NewDataValueNull(), nil
-	}
-
-	attributes := map[string]attr.Value{}
-
-	val := map[string]tftypes.Value{}
-
-	err := in.As(&val)
-
-	if err != nil {
-		return nil, err
-	}
-
-	for k, v := range val {
-		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
-
-		if err != nil {
-			return nil, err
-		}
-
-		attributes[k] = a
-	}
-
-	return NewDataValueMust(DataValue{}.AttributeTypes(ctx), attributes), nil
-}
-
-func (t DataType) ValueType(ctx context.Context) attr.Value {
-	return DataValue{}
-}
-
-var _ basetypes.ObjectValuable = DataValue{}
-
-type DataValue struct {
-	Attributes basetypes.ObjectValue `tfsdk:"attributes"`
-	Id         basetypes.StringValue `tfsdk:"id"`
-	DataType   basetypes.StringValue `tfsdk:"type"`
-	state      attr.ValueState
-}
-
-func (v DataValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 3)
-
-	var val tftypes.Value
-	var err error
-
-	attrTypes["attributes"] = basetypes.ObjectType{
-		AttrTypes: AttributesValue{}.AttributeTypes(ctx),
-	}.TerraformType(ctx)
-	attrTypes["id"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
-
-	objectType := tftypes.Object{AttributeTypes: attrTypes}
-
-	switch v.state {
-	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 3)
-
-		val, err = v.Attributes.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["attributes"] = val
-
-		val, err = v.Id.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["id"] = val
-
-		val, err = v.DataType.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["type"] = val
-
-		if err := tftypes.ValidateValue(objectType, vals); err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		return tftypes.NewValue(objectType, vals), nil
-	case attr.ValueStateNull:
-		return tftypes.NewValue(objectType, nil), nil
-	case attr.ValueStateUnknown:
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
-	default:
-		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
-	}
-}
-
-func (v DataValue) IsNull() bool {
-	return v.state == attr.ValueStateNull
-}
-
-func (v DataValue) IsUnknown() bool {
-	return v.state == attr.ValueStateUnknown
-}
-
-func (v DataValue) String() string {
-	return "DataValue"
-}
-
-func (v DataValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	var attributes basetypes.ObjectValue
-
-	if v.Attributes.IsNull() {
-		attributes = types.ObjectNull(
-			AttributesValue{}.AttributeTypes(ctx),
-		)
-	}
-
-	if v.Attributes.IsUnknown() {
-		attributes = types.ObjectUnknown(
-			AttributesValue{}.AttributeTypes(ctx),
-		)
-	}
-
-	if !v.Attributes.IsNull() && !v.Attributes.IsUnknown() {
-		attributes = types.ObjectValueMust(
-			AttributesValue{}.AttributeTypes(ctx),
-			v.Attributes.Attributes(),
-		)
-	}
-
-	attributeTypes := map[string]attr.Type{
-		"attributes": basetypes.ObjectType{
-			AttrTypes: AttributesValue{}.AttributeTypes(ctx),
-		},
-		"id":   basetypes.StringType{},
-		"type": basetypes.StringType{},
-	}
-
-	if v.IsNull() {
-		return types.ObjectNull(attributeTypes), diags
-	}
-
-	if v.IsUnknown() {
-		return types.ObjectUnknown(attributeTypes), diags
-	}
-
-	objVal, diags := types.ObjectValue(
-		attributeTypes,
-		map[string]attr.Value{
-			"attributes": attributes,
-			"id":         v.Id,
-			"type":       v.DataType,
-		})
-
-	return objVal, diags
-}
-
-func (v DataValue) Equal(o attr.Value) bool {
-	other, ok := o.(DataValue)
-
-	if !ok {
-		return false
-	}
-
-	if v.state != other.state {
-		return false
-	}
-
-	if v.state != attr.ValueStateKnown {
-		return true
-	}
-
-	if !v.Attributes.Equal(other.Attributes) {
-		return false
-	}
-
-	if !v.Id.Equal(other.Id) {
-		return false
-	}
-
-	if !v.DataType.Equal(other.DataType) {
-		return false
-	}
-
-	return true
-}
-
-func (v DataValue) Type(ctx context.Context) attr.Type {
-	return DataType{
-		basetypes.ObjectType{
-			AttrTypes: v.AttributeTypes(ctx),
-		},
-	}
-}
-
-func (v DataValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
-	return map[string]attr.Type{
-		"attributes": basetypes.ObjectType{
-			AttrTypes: AttributesValue{}.AttributeTypes(ctx),
-		},
-		"id":   basetypes.StringType{},
-		"type": basetypes.StringType{},
-	}
-}
-
-var _ basetypes.ObjectTypable = AttributesType{}
-
-type AttributesType struct {
-	basetypes.ObjectType
-}
-
-func (t AttributesType) Equal(o attr.Type) bool {
-	other, ok := o.(AttributesType)
-
-	if !ok {
-		return false
-	}
-
-	return t.ObjectType.Equal(other.ObjectType)
-}
-
-func (t AttributesType) String() string {
-	return "AttributesType"
-}
-
-func (t AttributesType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	attributes := in.Attributes()
-
-	commandAttribute, ok := attributes["command"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`command is missing from object`)
-
-		return nil, diags
-	}
-
-	commandVal, ok := commandAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`command expected to be basetypes.StringValue, was: %T`, commandAttribute))
-	}
-
-	createdAtAttribute, ok := attributes["created_at"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`created_at is missing from object`)
-
-		return nil, diags
-	}
-
-	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
-	}
-
-	directoryAttribute, ok := attributes["directory"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`directory is missing from object`)
-
-		return nil, diags
-	}
-
-	directoryVal, ok := directoryAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`directory expected to be basetypes.StringValue, was: %T`, directoryAttribute))
-	}
-
-	processesAttribute, ok := attributes["processes"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`processes is missing from object`)
-
-		return nil, diags
-	}
-
-	processesVal, ok := processesAttribute.(basetypes.Int64Value)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`processes expected to be basetypes.Int64Value, was: %T`, processesAttribute))
-	}
-
-	statusAttribute, ok := attributes["status"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`status is missing from object`)
-
-		return nil, diags
-	}
-
-	statusVal, ok := statusAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
-	}
-
-	userAttribute, ok := attributes["user"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`user is missing from object`)
-
-		return nil, diags
-	}
-
-	userVal, ok := userAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`user expected to be basetypes.StringValue, was: %T`, userAttribute))
-	}
-
-	if diags.HasError() {
-		return nil, diags
-	}
-
-	return AttributesValue{
-		Command:   commandVal,
-		CreatedAt: createdAtVal,
-		Directory: directoryVal,
-		Processes: processesVal,
-		Status:    statusVal,
-		User:      userVal,
-		state:     attr.ValueStateKnown,
-	}, diags
-}
-
-func NewAttributesValueNull() AttributesValue {
-	return AttributesValue{
-		state: attr.ValueStateNull,
-	}
-}
-
-func NewAttributesValueUnknown() AttributesValue {
-	return AttributesValue{
-		state: attr.ValueStateUnknown,
-	}
-}
-
-func NewAttributesValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (AttributesValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
-	ctx := context.Background()
-
-	for name, attributeType := range attributeTypes {
-		attribute, ok := attributes[name]
-
-		if !ok {
-			diags.AddError(
-				"Missing AttributesValue Attribute Value",
-				"While creating a AttributesValue value, a missing attribute value was detected. "+
-					"A AttributesValue must contain values for all attributes, even if null or unknown. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("AttributesValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
-			)
-
-			continue
-		}
-
-		if !attributeType.Equal(attribute.Type(ctx)) {
-			diags.AddError(
-				"Invalid AttributesValue Attribute Type",
-				"While creating a AttributesValue value, an invalid attribute value was detected. "+
-					"A AttributesValue must use a matching attribute type for the value. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("AttributesValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
-					fmt.Sprintf("AttributesValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
-			)
-		}
-	}
-
-	for name := range attributes {
-		_, ok := attributeTypes[name]
-
-		if !ok {
-			diags.AddError(
-				"Extra AttributesValue Attribute Value",
-				"While creating a AttributesValue value, an extra attribute value was detected. "+
-					"A AttributesValue must not contain values beyond the expected attribute types. "+
-					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-					fmt.Sprintf("Extra AttributesValue Attribute Name: %s", name),
-			)
-		}
-	}
-
-	if diags.HasError() {
-		return NewAttributesValueUnknown(), diags
-	}
-
-	commandAttribute, ok := attributes["command"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`command is missing from object`)
-
-		return NewAttributesValueUnknown(), diags
-	}
-
-	commandVal, ok := commandAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`command expected to be basetypes.StringValue, was: %T`, commandAttribute))
-	}
-
-	createdAtAttribute, ok := attributes["created_at"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`created_at is missing from object`)
-
-		return NewAttributesValueUnknown(), diags
-	}
-
-	createdAtVal, ok := createdAtAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`created_at expected to be basetypes.StringValue, was: %T`, createdAtAttribute))
-	}
-
-	directoryAttribute, ok := attributes["directory"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`directory is missing from object`)
-
-		return NewAttributesValueUnknown(), diags
-	}
-
-	directoryVal, ok := directoryAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`directory expected to be basetypes.StringValue, was: %T`, directoryAttribute))
-	}
-
-	processesAttribute, ok := attributes["processes"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`processes is missing from object`)
-
-		return NewAttributesValueUnknown(), diags
-	}
-
-	processesVal, ok := processesAttribute.(basetypes.Int64Value)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`processes expected to be basetypes.Int64Value, was: %T`, processesAttribute))
-	}
-
-	statusAttribute, ok := attributes["status"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`status is missing from object`)
-
-		return NewAttributesValueUnknown(), diags
-	}
-
-	statusVal, ok := statusAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`status expected to be basetypes.StringValue, was: %T`, statusAttribute))
-	}
-
-	userAttribute, ok := attributes["user"]
-
-	if !ok {
-		diags.AddError(
-			"Attribute Missing",
-			`user is missing from object`)
-
-		return NewAttributesValueUnknown(), diags
-	}
-
-	userVal, ok := userAttribute.(basetypes.StringValue)
-
-	if !ok {
-		diags.AddError(
-			"Attribute Wrong Type",
-			fmt.Sprintf(`user expected to be basetypes.StringValue, was: %T`, userAttribute))
-	}
-
-	if diags.HasError() {
-		return NewAttributesValueUnknown(), diags
-	}
-
-	return AttributesValue{
-		Command:   commandVal,
-		CreatedAt: createdAtVal,
-		Directory: directoryVal,
-		Processes: processesVal,
-		Status:    statusVal,
-		User:      userVal,
-		state:     attr.ValueStateKnown,
-	}, diags
-}
-
-func NewAttributesValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) AttributesValue {
-	object, diags := NewAttributesValue(attributeTypes, attributes)
-
-	if diags.HasError() {
-		// This could potentially be added to the diag package.
-		diagsStrings := make([]string, 0, len(diags))
-
-		for _, diagnostic := range diags {
-			diagsStrings = append(diagsStrings, fmt.Sprintf(
-				"%s | %s | %s",
-				diagnostic.Severity(),
-				diagnostic.Summary(),
-				diagnostic.Detail()))
-		}
-
-		panic("NewAttributesValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
-	}
-
-	return object
-}
-
-func (t AttributesType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	if in.Type() == nil {
-		return NewAttributesValueNull(), nil
-	}
-
-	if !in.Type().Equal(t.TerraformType(ctx)) {
-		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
-	}
-
-	if !in.IsKnown() {
-		return NewAttributesValueUnknown(), nil
-	}
-
-	if in.IsNull() {
-		return NewAttributesValueNull(), nil
-	}
-
-	attributes := map[string]attr.Value{}
-
-	val := map[string]tftypes.Value{}
-
-	err := in.As(&val)
-
-	if err != nil {
-		return nil, err
-	}
-
-	for k, v := range val {
-		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
-
-		if err != nil {
-			return nil, err
-		}
-
-		attributes[k] = a
-	}
-
-	return NewAttributesValueMust(AttributesValue{}.AttributeTypes(ctx), attributes), nil
-}
-
-func (t AttributesType) ValueType(ctx context.Context) attr.Value {
-	return AttributesValue{}
-}
-
-var _ basetypes.ObjectValuable = AttributesValue{}
-
-type AttributesValue struct {
-	Command   basetypes.StringValue `tfsdk:"command"`
-	CreatedAt basetypes.StringValue `tfsdk:"created_at"`
-	Directory basetypes.StringValue `tfsdk:"directory"`
-	Processes basetypes.Int64Value  `tfsdk:"processes"`
-	Status    basetypes.StringValue `tfsdk:"status"`
-	User      basetypes.StringValue `tfsdk:"user"`
-	state     attr.ValueState
-}
-
-func (v AttributesValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	attrTypes := make(map[string]tftypes.Type, 6)
-
-	var val tftypes.Value
-	var err error
-
-	attrTypes["command"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["created_at"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["directory"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["processes"] = basetypes.Int64Type{}.TerraformType(ctx)
-	attrTypes["status"] = basetypes.StringType{}.TerraformType(ctx)
-	attrTypes["user"] = basetypes.StringType{}.TerraformType(ctx)
-
-	objectType := tftypes.Object{AttributeTypes: attrTypes}
-
-	switch v.state {
-	case attr.ValueStateKnown:
-		vals := make(map[string]tftypes.Value, 6)
-
-		val, err = v.Command.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["command"] = val
-
-		val, err = v.CreatedAt.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["created_at"] = val
-
-		val, err = v.Directory.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["directory"] = val
-
-		val, err = v.Processes.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["processes"] = val
-
-		val, err = v.Status.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["status"] = val
-
-		val, err = v.User.ToTerraformValue(ctx)
-
-		if err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		vals["user"] = val
-
-		if err := tftypes.ValidateValue(objectType, vals); err != nil {
-			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
-		}
-
-		return tftypes.NewValue(objectType, vals), nil
-	case attr.ValueStateNull:
-		return tftypes.NewValue(objectType, nil), nil
-	case attr.ValueStateUnknown:
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
-	default:
-		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
-	}
-}
-
-func (v AttributesValue) IsNull() bool {
-	return v.state == attr.ValueStateNull
-}
-
-func (v AttributesValue) IsUnknown() bool {
-	return v.state == attr.ValueStateUnknown
-}
-
-func (v AttributesValue) String() string {
-	return "AttributesValue"
-}
-
-func (v AttributesValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	attributeTypes := map[string]attr.Type{
-		"command":    basetypes.StringType{},
-		"created_at": basetypes.StringType{},
-		"directory":  basetypes.StringType{},
-		"processes":  basetypes.Int64Type{},
-		"status":     basetypes.StringType{},
-		"user":       basetypes.StringType{},
-	}
-
-	if v.IsNull() {
-		return types.ObjectNull(attributeTypes), diags
-	}
-
-	if v.IsUnknown() {
-		return types.ObjectUnknown(attributeTypes), diags
-	}
-
-	objVal, diags := types.ObjectValue(
-		attributeTypes,
-		map[string]attr.Value{
-			"command":    v.Command,
-			"created_at": v.CreatedAt,
-			"directory":  v.Directory,
-			"processes":  v.Processes,
-			"status":     v.Status,
-			"user":       v.User,
-		})
-
-	return objVal, diags
-}
-
-func (v AttributesValue) Equal(o attr.Value) bool {
-	other, ok := o.(AttributesValue)
-
-	if !ok {
-		return false
-	}
-
-	if v.state != other.state {
-		return false
-	}
-
-	if v.state != attr.ValueStateKnown {
-		return true
-	}
-
-	if !v.Command.Equal(other.Command) {
-		return false
-	}
-
-	if !v.CreatedAt.Equal(other.CreatedAt) {
-		return false
-	}
-
-	if !v.Directory.Equal(other.Directory) {
-		return false
-	}
-
-	if !v.Processes.Equal(other.Processes) {
-		return false
-	}
-
-	if !v.Status.Equal(other.Status) {
-		return false
-	}
-
-	if !v.User.Equal(other.User) {
-		return false
-	}
-
-	return true
-}
-
-func (v AttributesValue) Type(ctx context.Context) attr.Type {
-	return AttributesType{
-		basetypes.ObjectType{
-			AttrTypes: v.AttributeTypes(ctx),
-		},
-	}
-}
-
-func (v AttributesValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
-	return map[string]attr.Type{
-		"command":    basetypes.StringType{},
-		"created_at": basetypes.StringType{},
-		"directory":  basetypes.StringType{},
-		"processes":  basetypes.Int64Type{},
-		"status":     basetypes.StringType{},
-		"user":       basetypes.StringType{},
-	}
-}
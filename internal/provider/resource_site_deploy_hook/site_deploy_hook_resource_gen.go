@@ -0,0 +1,48 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_deploy_hook
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteDeployHookResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The URL that triggers a deployment of the site. Creating the resource issues a new URL, which replaces the previous one.",
+				MarkdownDescription: "The URL that triggers a deployment of the site. Creating the resource issues a new URL, which replaces the previous one.",
+			},
+		},
+	}
+}
+
+type SiteDeployHookModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Server       types.Int64  `tfsdk:"server"`
+	Site         types.Int64  `tfsdk:"site"`
+	Url          types.String `tfsdk:"url"`
+}
@@ -0,0 +1,60 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_deployment_script
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteDeploymentScriptResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_source": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Make the .env variables available to the deployment script.",
+				MarkdownDescription: "Make the .env variables available to the deployment script.",
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				Description:         "The content of the deployment script.",
+				MarkdownDescription: "The content of the deployment script.",
+			},
+			"deploy_on_change": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Deploy the site after creating or changing the deployment script.",
+				MarkdownDescription: "Deploy the site after creating or changing the deployment script.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteDeploymentScriptModel struct {
+	AutoSource     types.Bool   `tfsdk:"auto_source"`
+	Content        types.String `tfsdk:"content"`
+	DeployOnChange types.Bool   `tfsdk:"deploy_on_change"`
+	Organization   types.String `tfsdk:"organization"`
+	Server         types.Int64  `tfsdk:"server"`
+	Site           types.Int64  `tfsdk:"site"`
+}
@@ -0,0 +1,47 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_healthcheck
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteHealthcheckResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"healthcheck_endpoint": schema.StringAttribute{
+				Required:            true,
+				Description:         "The URL Laravel Forge requests to check that the site is healthy, such as https://example.com/up. Destroying the resource removes it.",
+				MarkdownDescription: "The URL Laravel Forge requests to check that the site is healthy, such as https://example.com/up. Destroying the resource removes it.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteHealthcheckModel struct {
+	HealthcheckEndpoint types.String `tfsdk:"healthcheck_endpoint"`
+	Organization        types.String `tfsdk:"organization"`
+	Server              types.Int64  `tfsdk:"server"`
+	Site                types.Int64  `tfsdk:"site"`
+}
@@ -0,0 +1,53 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_inertia_integration
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteInertiaIntegrationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Whether the Inertia integration is enabled.",
+				MarkdownDescription: "Whether the Inertia integration is enabled.",
+			},
+			"inertia_installed": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether Inertia is installed on the site.",
+				MarkdownDescription: "Whether Inertia is installed on the site.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteInertiaIntegrationModel struct {
+	Enabled          types.String `tfsdk:"enabled"`
+	InertiaInstalled types.Bool   `tfsdk:"inertia_installed"`
+	Organization     types.String `tfsdk:"organization"`
+	Server           types.Int64  `tfsdk:"server"`
+	Site             types.Int64  `tfsdk:"site"`
+}
@@ -0,0 +1,53 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_laravel_scheduler_integration
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteLaravelSchedulerIntegrationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether the Laravel scheduler integration is enabled.",
+				MarkdownDescription: "Whether the Laravel scheduler integration is enabled.",
+			},
+			"laravel_installed": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether Laravel is installed on the site.",
+				MarkdownDescription: "Whether Laravel is installed on the site.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteLaravelSchedulerIntegrationModel struct {
+	Enabled          types.Bool   `tfsdk:"enabled"`
+	LaravelInstalled types.Bool   `tfsdk:"laravel_installed"`
+	Organization     types.String `tfsdk:"organization"`
+	Server           types.Int64  `tfsdk:"server"`
+	Site             types.Int64  `tfsdk:"site"`
+}
@@ -0,0 +1,656 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_load_balancing
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteLoadBalancingResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"balancer_method": schema.StringAttribute{
+				Required:            true,
+				Description:         "The method balancing requests over the nodes, one of round_robin, least_conn or ip_hash. The API does not return it, so changes made outside of Terraform are not detected.",
+				MarkdownDescription: "The method balancing requests over the nodes, one of round_robin, least_conn or ip_hash. The API does not return it, so changes made outside of Terraform are not detected.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("round_robin", "least_conn", "ip_hash"),
+				},
+			},
+			"balancing": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"backup": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Description:         "Only send requests to the server when the other nodes are unavailable. Defaults to false.",
+							MarkdownDescription: "Only send requests to the server when the other nodes are unavailable. Defaults to false.",
+						},
+						"down": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Description:         "Mark the server as unavailable. Defaults to false.",
+							MarkdownDescription: "Mark the server as unavailable. Defaults to false.",
+						},
+						"port": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Description:         "The port of the server. Defaults to the port of the site.",
+							MarkdownDescription: "The port of the server. Defaults to the port of the site.",
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"server_id": schema.Int64Attribute{
+							Required:            true,
+							Description:         "The ID of the server.",
+							MarkdownDescription: "The ID of the server.",
+						},
+						"weight": schema.Int64Attribute{
+							Required:            true,
+							Description:         "The share of requests sent to the server, relative to the other nodes.",
+							MarkdownDescription: "The share of requests sent to the server, relative to the other nodes.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+					CustomType: BalancingType{
+						ObjectType: types.ObjectType{
+							AttrTypes: BalancingValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Required:            true,
+				Description:         "The servers requests are balanced over. Nodes that are not listed are removed from the load balancer.",
+				MarkdownDescription: "The servers requests are balanced over. Nodes that are not listed are removed from the load balancer.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteLoadBalancingModel struct {
+	BalancerMethod types.String `tfsdk:"balancer_method"`
+	Balancing      types.List   `tfsdk:"balancing"`
+	Organization   types.String `tfsdk:"organization"`
+	Server         types.Int64  `tfsdk:"server"`
+	Site           types.Int64  `tfsdk:"site"`
+}
+
+var _ basetypes.ObjectTypable = BalancingType{}
+
+type BalancingType struct {
+	basetypes.ObjectType
+}
+
+func (t BalancingType) Equal(o attr.Type) bool {
+	other, ok := o.(BalancingType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t BalancingType) String() string {
+	return "BalancingType"
+}
+
+func (t BalancingType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	backupAttribute, ok := attributes["backup"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`backup is missing from object`)
+
+		return nil, diags
+	}
+
+	backupVal, ok := backupAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`backup expected to be basetypes.BoolValue, was: %T`, backupAttribute))
+	}
+
+	downAttribute, ok := attributes["down"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`down is missing from object`)
+
+		return nil, diags
+	}
+
+	downVal, ok := downAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`down expected to be basetypes.BoolValue, was: %T`, downAttribute))
+	}
+
+	portAttribute, ok := attributes["port"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`port is missing from object`)
+
+		return nil, diags
+	}
+
+	portVal, ok := portAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`port expected to be basetypes.Int64Value, was: %T`, portAttribute))
+	}
+
+	serverIdAttribute, ok := attributes["server_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`server_id is missing from object`)
+
+		return nil, diags
+	}
+
+	serverIdVal, ok := serverIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`server_id expected to be basetypes.Int64Value, was: %T`, serverIdAttribute))
+	}
+
+	weightAttribute, ok := attributes["weight"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`weight is missing from object`)
+
+		return nil, diags
+	}
+
+	weightVal, ok := weightAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`weight expected to be basetypes.Int64Value, was: %T`, weightAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return BalancingValue{
+		Backup:   backupVal,
+		Down:     downVal,
+		Port:     portVal,
+		ServerId: serverIdVal,
+		Weight:   weightVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewBalancingValueNull() BalancingValue {
+	return BalancingValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewBalancingValueUnknown() BalancingValue {
+	return BalancingValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewBalancingValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (BalancingValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing BalancingValue Attribute Value",
+				"While creating a BalancingValue value, a missing attribute value was detected. "+
+					"A BalancingValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("BalancingValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid BalancingValue Attribute Type",
+				"While creating a BalancingValue value, an invalid attribute value was detected. "+
+					"A BalancingValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("BalancingValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("BalancingValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra BalancingValue Attribute Value",
+				"While creating a BalancingValue value, an extra attribute value was detected. "+
+					"A BalancingValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra BalancingValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewBalancingValueUnknown(), diags
+	}
+
+	backupAttribute, ok := attributes["backup"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`backup is missing from object`)
+
+		return NewBalancingValueUnknown(), diags
+	}
+
+	backupVal, ok := backupAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`backup expected to be basetypes.BoolValue, was: %T`, backupAttribute))
+	}
+
+	downAttribute, ok := attributes["down"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`down is missing from object`)
+
+		return NewBalancingValueUnknown(), diags
+	}
+
+	downVal, ok := downAttribute.(basetypes.BoolValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`down expected to be basetypes.BoolValue, was: %T`, downAttribute))
+	}
+
+	portAttribute, ok := attributes["port"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`port is missing from object`)
+
+		return NewBalancingValueUnknown(), diags
+	}
+
+	portVal, ok := portAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`port expected to be basetypes.Int64Value, was: %T`, portAttribute))
+	}
+
+	serverIdAttribute, ok := attributes["server_id"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`server_id is missing from object`)
+
+		return NewBalancingValueUnknown(), diags
+	}
+
+	serverIdVal, ok := serverIdAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`server_id expected to be basetypes.Int64Value, was: %T`, serverIdAttribute))
+	}
+
+	weightAttribute, ok := attributes["weight"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`weight is missing from object`)
+
+		return NewBalancingValueUnknown(), diags
+	}
+
+	weightVal, ok := weightAttribute.(basetypes.Int64Value)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`weight expected to be basetypes.Int64Value, was: %T`, weightAttribute))
+	}
+
+	if diags.HasError() {
+		return NewBalancingValueUnknown(), diags
+	}
+
+	return BalancingValue{
+		Backup:   backupVal,
+		Down:     downVal,
+		Port:     portVal,
+		ServerId: serverIdVal,
+		Weight:   weightVal,
+		state:    attr.ValueStateKnown,
+	}, diags
+}
+
+func NewBalancingValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) BalancingValue {
+	object, diags := NewBalancingValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewBalancingValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t BalancingType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewBalancingValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewBalancingValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewBalancingValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewBalancingValueMust(BalancingValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t BalancingType) ValueType(ctx context.Context) attr.Value {
+	return BalancingValue{}
+}
+
+var _ basetypes.ObjectValuable = BalancingValue{}
+
+type BalancingValue struct {
+	Backup   basetypes.BoolValue  `tfsdk:"backup"`
+	Down     basetypes.BoolValue  `tfsdk:"down"`
+	Port     basetypes.Int64Value `tfsdk:"port"`
+	ServerId basetypes.Int64Value `tfsdk:"server_id"`
+	Weight   basetypes.Int64Value `tfsdk:"weight"`
+	state    attr.ValueState
+}
+
+func (v BalancingValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 5)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["backup"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["down"] = basetypes.BoolType{}.TerraformType(ctx)
+	attrTypes["port"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["server_id"] = basetypes.Int64Type{}.TerraformType(ctx)
+	attrTypes["weight"] = basetypes.Int64Type{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 5)
+
+		val, err = v.Backup.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["backup"] = val
+
+		val, err = v.Down.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["down"] = val
+
+		val, err = v.Port.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["port"] = val
+
+		val, err = v.ServerId.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["server_id"] = val
+
+		val, err = v.Weight.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["weight"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v BalancingValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v BalancingValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v BalancingValue) String() string {
+	return "BalancingValue"
+}
+
+func (v BalancingValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"backup":    basetypes.BoolType{},
+		"down":      basetypes.BoolType{},
+		"port":      basetypes.Int64Type{},
+		"server_id": basetypes.Int64Type{},
+		"weight":    basetypes.Int64Type{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"backup":    v.Backup,
+			"down":      v.Down,
+			"port":      v.Port,
+			"server_id": v.ServerId,
+			"weight":    v.Weight,
+		})
+
+	return objVal, diags
+}
+
+func (v BalancingValue) Equal(o attr.Value) bool {
+	other, ok := o.(BalancingValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.Backup.Equal(other.Backup) {
+		return false
+	}
+
+	if !v.Down.Equal(other.Down) {
+		return false
+	}
+
+	if !v.Port.Equal(other.Port) {
+		return false
+	}
+
+	if !v.ServerId.Equal(other.ServerId) {
+		return false
+	}
+
+	if !v.Weight.Equal(other.Weight) {
+		return false
+	}
+
+	return true
+}
+
+func (v BalancingValue) Type(ctx context.Context) attr.Type {
+	return BalancingType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v BalancingValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"backup":    basetypes.BoolType{},
+		"down":      basetypes.BoolType{},
+		"port":      basetypes.Int64Type{},
+		"server_id": basetypes.Int64Type{},
+		"weight":    basetypes.Int64Type{},
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_nginx_config
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteNginxConfigResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The content of the nginx configuration file. Required. Changes that only add or remove whitespace are ignored.",
+				MarkdownDescription: "The content of the nginx configuration file. Required. Changes that only add or remove whitespace are ignored.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteNginxConfigModel struct {
+	Content      types.String `tfsdk:"content"`
+	Organization types.String `tfsdk:"organization"`
+	Server       types.Int64  `tfsdk:"server"`
+	Site         types.Int64  `tfsdk:"site"`
+}
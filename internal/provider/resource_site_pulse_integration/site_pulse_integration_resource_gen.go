@@ -0,0 +1,53 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_pulse_integration
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SitePulseIntegrationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Whether the Laravel Pulse integration is enabled.",
+				MarkdownDescription: "Whether the Laravel Pulse integration is enabled.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"pulse_installed": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether Laravel Pulse is installed on the site.",
+				MarkdownDescription: "Whether Laravel Pulse is installed on the site.",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SitePulseIntegrationModel struct {
+	Enabled        types.String `tfsdk:"enabled"`
+	Organization   types.String `tfsdk:"organization"`
+	PulseInstalled types.Bool   `tfsdk:"pulse_installed"`
+	Server         types.Int64  `tfsdk:"server"`
+	Site           types.Int64  `tfsdk:"site"`
+}
@@ -0,0 +1,48 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_push_to_deploy
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SitePushToDeployResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The branch of the repository that triggers a deployment when pushed to. Defaults to the branch of the site.",
+				MarkdownDescription: "The branch of the repository that triggers a deployment when pushed to. Defaults to the branch of the site.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SitePushToDeployModel struct {
+	Branch       types.String `tfsdk:"branch"`
+	Organization types.String `tfsdk:"organization"`
+	Server       types.Int64  `tfsdk:"server"`
+	Site         types.Int64  `tfsdk:"site"`
+}
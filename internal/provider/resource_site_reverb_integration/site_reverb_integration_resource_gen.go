@@ -0,0 +1,72 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_site_reverb_integration
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func SiteReverbIntegrationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"connections": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The maximum number of connections, between 1 and 50000.",
+				MarkdownDescription: "The maximum number of connections, between 1 and 50000.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50000),
+				},
+			},
+			"enabled": schema.StringAttribute{
+				Computed: true,
+			},
+			"host": schema.StringAttribute{
+				Required:            true,
+				Description:         "The host name of the Reverb server.",
+				MarkdownDescription: "The host name of the Reverb server.",
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"port": schema.StringAttribute{
+				Required:            true,
+				Description:         "The port Reverb listens on.",
+				MarkdownDescription: "The port Reverb listens on.",
+			},
+			"reverb_installed": schema.BoolAttribute{
+				Computed: true,
+			},
+			"server": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The server ID",
+				MarkdownDescription: "The server ID",
+			},
+			"site": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The site ID",
+				MarkdownDescription: "The site ID",
+			},
+		},
+	}
+}
+
+type SiteReverbIntegrationModel struct {
+	Connections     types.Int64  `tfsdk:"connections"`
+	Enabled         types.String `tfsdk:"enabled"`
+	Host            types.String `tfsdk:"host"`
+	Organization    types.String `tfsdk:"organization"`
+	Port            types.String `tfsdk:"port"`
+	ReverbInstalled types.Bool   `tfsdk:"reverb_installed"`
+	Server          types.Int64  `tfsdk:"server"`
+	Site            types.Int64  `tfsdk:"site"`
+}
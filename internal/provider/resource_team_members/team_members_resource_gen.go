@@ -0,0 +1,63 @@
+// Code generated by terraform-plugin-framework-generator DO NOT EDIT.
+
+package resource_team_members
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TeamMembersResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"email": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The organization slug",
+				MarkdownDescription: "The organization slug",
+			},
+			"role_id": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The ID of the role to assign to the team member.",
+				MarkdownDescription: "The ID of the role to assign to the team member.",
+			},
+			"team": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The team ID",
+				MarkdownDescription: "The team ID",
+			},
+			"updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"user": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The user ID",
+				MarkdownDescription: "The user ID",
+			},
+		},
+	}
+}
+
+type TeamMembersModel struct {
+	CreatedAt    types.String `tfsdk:"created_at"`
+	Email        types.String `tfsdk:"email"`
+	Name         types.String `tfsdk:"name"`
+	Organization types.String `tfsdk:"organization"`
+	RoleId       types.Int64  `tfsdk:"role_id"`
+	Team         types.Int64  `tfsdk:"team"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	User         types.Int64  `tfsdk:"user"`
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_roles"
+)
+
+// NewRolesResource returns the laravelforge_roles resource, which manages a
+// role of the organization.
+func NewRolesResource() resource.Resource {
+	return &apiResource{
+		name:   "roles",
+		schema: resource_roles.RolesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/roles"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/roles/{role}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/roles/{role}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/roles/{role}"},
+	}
+}
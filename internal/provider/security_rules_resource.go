@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_security_rules"
+)
+
+// NewSecurityRulesResource returns the laravelforge_security_rules resource,
+// which manages a basic authentication rule of a site.
+func NewSecurityRulesResource() resource.Resource {
+	return &apiResource{
+		name:   "security_rules",
+		schema: resource_security_rules.SecurityRulesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/security-rules"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/security-rules/{securityRule}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/security-rules/{securityRule}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/security-rules/{securityRule}"},
+	}
+}
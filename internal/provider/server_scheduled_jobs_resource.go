@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_server_scheduled_jobs"
+)
+
+// NewServerScheduledJobsResource returns the
+// laravelforge_server_scheduled_jobs resource, which manages a scheduled job
+// of a server.
+func NewServerScheduledJobsResource() resource.Resource {
+	return &apiResource{
+		name:   "server_scheduled_jobs",
+		schema: resource_server_scheduled_jobs.ServerScheduledJobsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/scheduled-jobs"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/scheduled-jobs/{job}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/scheduled-jobs/{job}"},
+	}
+}
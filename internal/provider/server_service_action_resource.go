@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_server_service_action"
+)
+
+var _ resource.ResourceWithValidateConfig = &serverServiceActionResource{}
+
+// serverServiceActionResource runs an action on a server or one of its
+// services when it is created. Every change replaces the resource, so
+// changing the triggers runs the action again.
+//
+// The API does not report the progress of actions. Rebooting the server
+// waits until the server disconnects and is connected again, actions on
+// services are not waited for as the API does not report their state. A
+// reboot that is never seen only warns, the server may have been offline
+// between two polls.
+type serverServiceActionResource struct {
+	actionResource
+}
+
+// NewServerServiceActionResource returns the
+// laravelforge_server_service_action resource, which runs an action such as
+// restarting PHP-FPM on a server.
+func NewServerServiceActionResource() resource.Resource {
+	return &serverServiceActionResource{actionResource{&apiResource{
+		name:   "server_service_action",
+		schema: resource_server_service_action.ServerServiceActionResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/actions"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}"},
+
+		ready:         serverReady,
+		createTimeout: 10 * time.Minute,
+	}}}
+}
+
+func (r *serverServiceActionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config, err := objectValues(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+
+		return
+	}
+
+	var service, action string
+
+	if !config["service"].IsKnown() || !config["action"].IsKnown() || !config["version"].IsKnown() {
+		return
+	}
+
+	_ = config["service"].As(&service)
+	_ = config["action"].As(&action)
+
+	switch {
+	case service == "php" && config["version"].IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Missing PHP version", "The version attribute is required when service is php.")
+	case service != "php" && !config["version"].IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "Invalid attribute combination", "The version attribute can only be set when service is php.")
+	}
+
+	if action == "stop" && !contains([]string{"nginx", "mysql", "postgres"}, service) {
+		resp.Diagnostics.AddAttributeError(path.Root("action"), "Invalid attribute combination", "Only the nginx, mysql and postgres services can be stopped.")
+	}
+}
+
+// Create sends the action to the endpoint of the service, or to the server
+// which it then waits for to reboot.
+func (r *serverServiceActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	op := r.create
+	service := isSet(plan["service"])
+
+	if service {
+		var service string
+		_ = plan["service"].As(&service)
+
+		op.path = "/orgs/{organization}/servers/{server}/services/" + service + "/actions"
+	}
+
+	body, err := r.requestBody(plan, map[string]string{"action": "action", "version": "version"})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build request", err.Error())
+
+		return
+	}
+
+	if _, ok := r.do(ctx, op, plan, body, "create", &resp.Diagnostics); !ok {
+		return
+	}
+
+	for name, v := range plan {
+		plan[name] = nullUnknowns(v)
+	}
+
+	resp.State.Raw = tftypes.NewValue(req.Plan.Raw.Type(), plan)
+
+	if service {
+		return
+	}
+
+	var createTimeouts timeouts.Value
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeouts"), &createTimeouts)...)
+
+	timeout, diags := createTimeouts.Create(ctx, r.createTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var reboot rebootStatus
+
+	// The action was sent, so the resource stays in the state when waiting
+	// fails and Terraform marks it as tainted, which runs it again.
+	_, err = r.waitReady(ctx, plan, nil, reboot.ready, timeout)
+
+	var notReady *notReadyError
+
+	switch {
+	case errors.As(err, &notReady) && !reboot.rebooting:
+		// The server may have rebooted between two polls. Running the
+		// action again would not tell either, so it is not tainted.
+		resp.Diagnostics.AddWarning(
+			"Unable to confirm the reboot of the server",
+			fmt.Sprintf("The server was connected every time it was checked for %s after the reboot was sent, so it either rebooted between two checks or did not reboot. Last status: %s", timeout, notReady.status),
+		)
+	case err != nil:
+		resp.Diagnostics.AddError("Error waiting for the action of "+r.typeName+" to finish", err.Error())
+	}
+}
+
+// rebootStatus follows a server through a reboot. Its ready method reports
+// the server ready once it stopped being connected and is connected again.
+// The server is still connected right after the action is sent, and its
+// connection fails while it is offline, which does not stop waiting.
+type rebootStatus struct {
+	// rebooting is set once the server was seen disconnected.
+	rebooting bool
+}
+
+func (s *rebootStatus) ready(attributes map[string]any) (string, bool, error) {
+	isReady, _ := attributes["is_ready"].(bool)
+	connectionStatus, _ := attributes["connection_status"].(string)
+	status := fmt.Sprintf("is_ready=%t, connection_status=%q", isReady, connectionStatus)
+
+	if revoked, _ := attributes["revoked"].(bool); revoked {
+		return status, false, fmt.Errorf("access to the server was revoked while rebooting, last status: %s", status)
+	}
+
+	connected := isReady && connectionStatus == "connected"
+
+	if attributes != nil && !connected {
+		s.rebooting = true
+	}
+
+	return status, s.rebooting && connected, nil
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+)
+
+func TestRebootStatus(t *testing.T) {
+	connected := map[string]any{"is_ready": true, "connection_status": "connected"}
+	disconnected := map[string]any{"is_ready": true, "connection_status": "disconnected"}
+	failed := map[string]any{"is_ready": true, "connection_status": "failed"}
+	revoked := map[string]any{"is_ready": true, "connection_status": "connected", "revoked": true}
+
+	tests := []struct {
+		name string
+
+		// polls are the attributes of the server at each poll, starting
+		// with nil as waitReady does before the first request.
+		polls []map[string]any
+
+		// want is the ready result of every poll.
+		want          []bool
+		wantRebooting bool
+		wantErr       bool
+	}{
+		{
+			name:          "disconnects and reconnects",
+			polls:         []map[string]any{nil, connected, disconnected, disconnected, connected},
+			want:          []bool{false, false, false, false, true},
+			wantRebooting: true,
+		},
+		{
+			name:          "connection fails while offline",
+			polls:         []map[string]any{nil, failed, connected},
+			want:          []bool{false, false, true},
+			wantRebooting: true,
+		},
+		{
+			name:  "never seen disconnected",
+			polls: []map[string]any{nil, connected, connected},
+			want:  []bool{false, false, false},
+		},
+		{
+			name:          "never reconnects",
+			polls:         []map[string]any{nil, disconnected, failed},
+			want:          []bool{false, false, false},
+			wantRebooting: true,
+		},
+		{
+			name:    "access revoked",
+			polls:   []map[string]any{nil, revoked},
+			want:    []bool{false, false},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s rebootStatus
+
+			for i, attributes := range tt.polls {
+				_, ready, err := s.ready(attributes)
+
+				if err != nil {
+					if !tt.wantErr || i != len(tt.polls)-1 {
+						t.Fatalf("poll %d: ready() error = %v", i, err)
+					}
+
+					return
+				}
+
+				if ready != tt.want[i] {
+					t.Errorf("poll %d: ready() = %t, want %t", i, ready, tt.want[i])
+				}
+			}
+
+			if tt.wantErr {
+				t.Fatal("ready() did not return an error")
+			}
+
+			if s.rebooting != tt.wantRebooting {
+				t.Errorf("rebooting = %t, want %t", s.rebooting, tt.wantRebooting)
+			}
+		})
+	}
+}
+
+func TestCreateReboot(t *testing.T) {
+	defer func(interval time.Duration) { pollInterval = interval }(pollInterval)
+	pollInterval = time.Millisecond
+
+	tests := []struct {
+		name string
+
+		// statuses are the connection statuses the fake API returns after
+		// the reboot is sent, the last one repeating.
+		statuses []string
+
+		wantSeverity diag.Severity
+	}{
+		{
+			name:     "rebooted",
+			statuses: []string{"connected", "failed", "connected"},
+		},
+		{
+			name:         "reboot not seen",
+			statuses:     []string{"connected"},
+			wantSeverity: diag.SeverityWarning,
+		},
+		{
+			name:         "not reconnected",
+			statuses:     []string{"connected", "disconnected"},
+			wantSeverity: diag.SeverityError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polls int
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/orgs/acme/servers/1/actions":
+					w.WriteHeader(http.StatusAccepted)
+				case r.Method == http.MethodGet && r.URL.Path == "/orgs/acme/servers/1":
+					status := tt.statuses[len(tt.statuses)-1]
+					if polls < len(tt.statuses) {
+						status = tt.statuses[polls]
+					}
+
+					polls++
+
+					fmt.Fprintf(w, `{"data":{"id":"1","type":"servers","attributes":{"is_ready":true,"connection_status":%q}}}`, status)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+			}))
+			defer server.Close()
+
+			client, err := forge.NewClient(forge.Config{BaseURL: server.URL, Token: "token"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := NewServerServiceActionResource().(*serverServiceActionResource)
+			r.client = client
+			r.createTimeout = 50 * time.Millisecond
+
+			var schemaResp resource.SchemaResponse
+
+			r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+
+			values := map[string]tftypes.Value{}
+			for name, typ := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+
+			values["organization"] = tftypes.NewValue(tftypes.String, "acme")
+			values["server"] = tftypes.NewValue(tftypes.Number, 1)
+			values["action"] = tftypes.NewValue(tftypes.String, "reboot")
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+			resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+
+			r.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+
+			for _, d := range resp.Diagnostics {
+				if d.Severity() != tt.wantSeverity {
+					t.Errorf("unexpected diagnostic: %s: %s", d.Summary(), d.Detail())
+				}
+			}
+
+			if tt.wantSeverity != 0 && len(resp.Diagnostics) != 1 {
+				t.Errorf("got %d diagnostics, want 1", len(resp.Diagnostics))
+			}
+
+			if resp.State.Raw.IsNull() {
+				t.Error("Create() did not save the state")
+			}
+		})
+	}
+}
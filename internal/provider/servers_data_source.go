@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/datasource_servers"
+)
+
+// NewServersDataSource returns the laravelforge_servers data source, which
+// lists the servers of an organization matching the configured filters.
+func NewServersDataSource() datasource.DataSource {
+	return &listDataSource{
+		name:   "servers",
+		schema: datasource_servers.ServersDataSourceSchema,
+		path:   "/orgs/{organization}/servers",
+		list:   "servers",
+
+		filters: map[string]string{
+			"name":           "filter[name]",
+			"ip_address":     "filter[ip_address]",
+			"region":         "filter[region]",
+			"size":           "filter[size]",
+			"provider_name":  "filter[provider]",
+			"ubuntu_version": "filter[ubuntu_version]",
+			"php_version":    "filter[php_version]",
+			"database_type":  "filter[database_type]",
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_servers"
+)
+
+// NewServersResource returns the laravelforge_servers resource, which manages
+// a server provisioned by Laravel Forge.
+func NewServersResource() resource.Resource {
+	return &apiResource{
+		name:   "servers",
+		schema: resource_servers.ServersResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}"},
+
+		fields: map[string]string{"provider_name": "provider"},
+
+		// Provisioning a server takes a while after the API accepts it.
+		ready:         serverReady,
+		createTimeout: 30 * time.Minute,
+	}
+}
+
+// serverReady reports whether a server is provisioned and Laravel Forge can
+// connect to it.
+func serverReady(attributes map[string]any) (string, bool, error) {
+	isReady, _ := attributes["is_ready"].(bool)
+	connectionStatus, _ := attributes["connection_status"].(string)
+	status := fmt.Sprintf("is_ready=%t, connection_status=%q", isReady, connectionStatus)
+
+	if revoked, _ := attributes["revoked"].(bool); revoked {
+		return status, false, fmt.Errorf("access to the server was revoked while provisioning, last status: %s", status)
+	}
+
+	if connectionStatus == "failed" {
+		return status, false, fmt.Errorf("provisioning failed, last status: %s", status)
+	}
+
+	return status, isReady && connectionStatus == "connected", nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import "testing"
+
+func TestServerReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		want       bool
+		wantErr    bool
+	}{
+		{
+			name:       "provisioned and connected",
+			attributes: map[string]any{"is_ready": true, "connection_status": "connected"},
+			want:       true,
+		},
+		{
+			name:       "provisioning",
+			attributes: map[string]any{"is_ready": false, "connection_status": "connecting"},
+		},
+		{
+			name:       "provisioned but not connected",
+			attributes: map[string]any{"is_ready": true, "connection_status": "disconnected"},
+		},
+		{
+			name:       "connected but not provisioned",
+			attributes: map[string]any{"is_ready": false, "connection_status": "connected"},
+		},
+		{
+			name:       "connection failed",
+			attributes: map[string]any{"is_ready": false, "connection_status": "failed"},
+			wantErr:    true,
+		},
+		{
+			name:       "access revoked",
+			attributes: map[string]any{"is_ready": true, "connection_status": "connected", "revoked": true},
+			wantErr:    true,
+		},
+		{
+			name: "no attributes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, got, err := serverReady(tt.attributes)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("serverReady() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("serverReady() = %t (%s), want %t", got, status, tt.want)
+			}
+		})
+	}
+}
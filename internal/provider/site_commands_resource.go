@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_commands"
+)
+
+// NewSiteCommandsResource returns the laravelforge_site_commands resource,
+// which manages a command run on a site. Commands cannot be changed once run.
+func NewSiteCommandsResource() resource.Resource {
+	return &apiResource{
+		name:   "site_commands",
+		schema: resource_site_commands.SiteCommandsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/commands"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/commands/{command}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/commands/{command}"},
+
+		aliases: map[string]string{"command": "command_id"},
+	}
+}
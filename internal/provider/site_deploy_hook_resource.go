@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_deploy_hook"
+)
+
+// NewSiteDeployHookResource returns the laravelforge_site_deploy_hook
+// resource, which issues the URL that triggers a deployment of a site.
+// Replacing the resource issues a new URL.
+func NewSiteDeployHookResource() resource.Resource {
+	return &apiResource{
+		name:   "site_deploy_hook",
+		schema: resource_site_deploy_hook.SiteDeployHookResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/deploy-hook"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/deploy-hook"},
+	}
+}
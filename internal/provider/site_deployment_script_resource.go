@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_deployment_script"
+)
+
+// siteDeploymentScriptResource manages the deployment script of a site and
+// deploys the site after changing it when deploy_on_change is set.
+type siteDeploymentScriptResource struct {
+	*apiResource
+}
+
+// NewSiteDeploymentScriptResource returns the
+// laravelforge_site_deployment_script resource, which manages the deployment
+// script of a site.
+func NewSiteDeploymentScriptResource() resource.Resource {
+	return &siteDeploymentScriptResource{&apiResource{
+		name:   "site_deployment_script",
+		schema: resource_site_deployment_script.SiteDeploymentScriptResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/script"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/script"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/script"},
+
+		local: []string{"deploy_on_change"},
+		equal: map[string]func(string, string) bool{"content": sameText},
+	}}
+}
+
+func (r *siteDeploymentScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.apiResource.Create(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		r.deploy(ctx, req.Plan.Raw, tftypes.Value{}, &resp.Diagnostics)
+	}
+}
+
+func (r *siteDeploymentScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.apiResource.Update(ctx, req, resp)
+
+	if !resp.Diagnostics.HasError() {
+		r.deploy(ctx, req.Plan.Raw, req.State.Raw, &resp.Diagnostics)
+	}
+}
+
+// deploy deploys the site when deploy_on_change is set and the content of
+// the script in plan differs from the one in prior.
+func (r *siteDeploymentScriptResource) deploy(ctx context.Context, planned, prior tftypes.Value, diags *diag.Diagnostics) {
+	plan, err := objectValues(planned)
+	if err != nil {
+		diags.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	state, err := objectValues(prior)
+	if err != nil {
+		diags.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	var deployOnChange bool
+	if isSet(plan["deploy_on_change"]) {
+		_ = plan["deploy_on_change"].As(&deployOnChange)
+	}
+
+	if !deployOnChange || plan["content"].Equal(state["content"]) {
+		return
+	}
+
+	deployment := operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments"}
+
+	path, err := r.path(deployment, plan)
+	if err == nil {
+		err = r.client.Do(ctx, deployment.method, path, nil, nil, nil)
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Unable to deploy site",
+			"The deployment script was saved, but the site was not deployed. Deploy the site from Laravel Forge to use the new script.\n\n"+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_domains"
+)
+
+// NewSiteDomainsResource returns the laravelforge_site_domains resource,
+// which manages a domain of a site.
+func NewSiteDomainsResource() resource.Resource {
+	return &apiResource{
+		name:   "site_domains",
+		schema: resource_site_domains.SiteDomainsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}"},
+		update: &operation{method: http.MethodPatch, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/domains/{domainRecord}"},
+
+		updateFields: map[string]string{
+			"allow_wildcard_subdomains": "allow_wildcard_subdomains",
+			"www_redirect_type":         "www_redirect_type",
+		},
+	}
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_environment"
+)
+
+var _ resource.ResourceWithConfigValidators = &siteEnvironmentResource{}
+
+// siteEnvironmentResource manages the .env file of a site, either as a
+// whole from content or as variables. With merge set, only the variables
+// are managed and the other lines of the file are kept.
+type siteEnvironmentResource struct {
+	*apiResource
+}
+
+// NewSiteEnvironmentResource returns the laravelforge_site_environment
+// resource, which manages the .env file of a site.
+func NewSiteEnvironmentResource() resource.Resource {
+	return &siteEnvironmentResource{&apiResource{
+		name:   "site_environment",
+		schema: resource_site_environment.SiteEnvironmentResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/environment"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/environment"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/environment"},
+	}}
+}
+
+func (r *siteEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	r.apiResource.Schema(ctx, req, resp)
+
+	if variables, ok := resp.Schema.Attributes["variables"].(schema.MapAttribute); ok {
+		variables.Validators = append(variables.Validators, mapvalidator.KeysAre(
+			stringvalidator.RegexMatches(envKey, "must be a valid .env variable name"),
+		))
+		resp.Schema.Attributes["variables"] = variables
+	}
+}
+
+func (r *siteEnvironmentResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("content"), path.MatchRoot("variables")),
+		resourcevalidator.Conflicting(path.MatchRoot("content"), path.MatchRoot("merge")),
+	}
+}
+
+func (r *siteEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.write(ctx, req.Plan.Raw, tftypes.Value{}, "create", &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *siteEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.write(ctx, req.Plan.Raw, req.State.Raw, "update", &resp.State.Raw, &resp.Diagnostics)
+}
+
+// write sends the .env file of the plan to the API and stores the plan in
+// the state. The API applies the file asynchronously, so it is not read
+// back. Variables in prior that are no longer planned are removed when
+// merging.
+func (r *siteEnvironmentResource) write(ctx context.Context, planned, prior tftypes.Value, action string, state *tftypes.Value, diags *diag.Diagnostics) {
+	plan, err := objectValues(planned)
+	if err != nil {
+		diags.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	previous, err := objectValues(prior)
+	if err != nil {
+		diags.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	var content string
+
+	if isSet(plan["content"]) {
+		if err := plan["content"].As(&content); err != nil {
+			diags.AddAttributeError(path.Root("content"), "Invalid value", err.Error())
+
+			return
+		}
+	} else {
+		variables, err := envVariables(plan["variables"])
+		if err != nil {
+			diags.AddAttributeError(path.Root("variables"), "Invalid value", err.Error())
+
+			return
+		}
+
+		if merging(plan) {
+			current, ok := r.content(ctx, plan, diags)
+			if !ok {
+				return
+			}
+
+			managed, err := envVariables(previous["variables"])
+			if err != nil {
+				diags.AddAttributeError(path.Root("variables"), "Invalid value", err.Error())
+
+				return
+			}
+
+			var remove []string
+
+			for key := range managed {
+				if _, ok := variables[key]; !ok {
+					remove = append(remove, key)
+				}
+			}
+
+			content = mergeEnv(current, variables, remove)
+		} else {
+			content = renderEnv(variables)
+		}
+	}
+
+	body, err := r.requestBody(plan, map[string]string{"cache": "cache", "queues": "queues", "encryption_key": "encryption_key"})
+	if err != nil {
+		diags.AddError("Unable to build request", err.Error())
+
+		return
+	}
+
+	body["environment"] = content
+
+	if _, ok := r.do(ctx, *r.update, plan, body, action, diags); !ok {
+		return
+	}
+
+	for name, v := range plan {
+		plan[name] = nullUnknowns(v)
+	}
+
+	*state = tftypes.NewValue(planned.Type(), plan)
+}
+
+// Read refreshes content or variables from the .env file. When merging,
+// only the variables in the state are refreshed, otherwise variables set
+// outside of Terraform show up as changes.
+func (r *siteEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	readPath, err := r.path(r.read, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	var doc struct {
+		Data *forge.Resource `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, readPath, nil, &doc); err != nil {
+		if forge.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	var current string
+	if doc.Data != nil {
+		current, _ = doc.Data.Attributes["content"].(string)
+	}
+
+	if isSet(state["variables"]) {
+		managed, err := envVariables(state["variables"])
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("variables"), "Invalid value", err.Error())
+
+			return
+		}
+
+		variables := map[string]any{}
+
+		for key, value := range parseEnv(current) {
+			if _, ok := managed[key]; ok || !merging(state) {
+				variables[key] = value
+			}
+		}
+
+		if state["variables"], err = fromJSON(state["variables"].Type(), variables); err != nil {
+			resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+			return
+		}
+	} else {
+		var content string
+		if isSet(state["content"]) {
+			_ = state["content"].As(&content)
+		}
+
+		if !isSet(state["content"]) || !sameText(content, current) {
+			state["content"] = tftypes.NewValue(tftypes.String, current)
+		}
+	}
+
+	resp.State.Raw = tftypes.NewValue(req.State.Raw.Type(), state)
+}
+
+// content returns the current content of the .env file.
+func (r *siteEnvironmentResource) content(ctx context.Context, values map[string]tftypes.Value, diags *diag.Diagnostics) (string, bool) {
+	data, ok := r.do(ctx, r.read, values, nil, "read", diags)
+	if !ok {
+		return "", false
+	}
+
+	attributes, _ := data["attributes"].(map[string]any)
+	content, _ := attributes["content"].(string)
+
+	return content, true
+}
+
+// envVariables returns the variables of a map value, which are empty when
+// the value is null.
+func envVariables(v tftypes.Value) (map[string]string, error) {
+	variables := map[string]string{}
+
+	value, err := toJSON(v)
+	if err != nil {
+		return nil, err
+	}
+
+	m, _ := value.(map[string]any)
+	for key, item := range m {
+		variables[key], _ = item.(string)
+	}
+
+	return variables, nil
+}
+
+func merging(values map[string]tftypes.Value) bool {
+	var merge bool
+	if isSet(values["merge"]) {
+		_ = values["merge"].As(&merge)
+	}
+
+	return merge
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_healthcheck"
+)
+
+// siteHealthcheckResource manages the healthcheck endpoint of a site. The
+// API has no delete operation, so destroying it clears the endpoint.
+type siteHealthcheckResource struct {
+	*apiResource
+}
+
+// NewSiteHealthcheckResource returns the laravelforge_site_healthcheck
+// resource, which manages the healthcheck endpoint of a site.
+func NewSiteHealthcheckResource() resource.Resource {
+	return &siteHealthcheckResource{&apiResource{
+		name:   "site_healthcheck",
+		schema: resource_site_healthcheck.SiteHealthcheckResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/healthcheck"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/healthcheck"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/healthcheck"},
+		delete: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/healthcheck"},
+	}}
+}
+
+func (r *siteHealthcheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	deletePath, err := r.path(*r.delete, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete "+r.typeName, err.Error())
+
+		return
+	}
+
+	err = r.client.Put(ctx, deletePath, map[string]any{"healthcheck_endpoint": nil}, nil)
+	if err != nil && !forge.IsNotFound(err) {
+		resp.Diagnostics.AddError("Unable to delete "+r.typeName, err.Error())
+	}
+}
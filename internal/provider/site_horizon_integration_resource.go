@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_horizon_integration"
+)
+
+// NewSiteHorizonIntegrationResource returns the
+// laravelforge_site_horizon_integration resource, which enables Laravel
+// Horizon on a site. Destroying it disables the integration.
+func NewSiteHorizonIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_horizon_integration",
+		schema: resource_site_horizon_integration.SiteHorizonIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/horizon"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/horizon"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/horizon"},
+		exists: integrationEnabled,
+	}
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_inertia_integration"
+)
+
+// NewSiteInertiaIntegrationResource returns the
+// laravelforge_site_inertia_integration resource, which enables Inertia on a
+// site. The API cannot disable the integration.
+func NewSiteInertiaIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_inertia_integration",
+		schema: resource_site_inertia_integration.SiteInertiaIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/inertia"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/inertia"},
+		exists: integrationEnabled,
+	}
+}
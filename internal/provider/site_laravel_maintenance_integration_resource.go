@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_laravel_maintenance_integration"
+)
+
+// NewSiteLaravelMaintenanceIntegrationResource returns the
+// laravelforge_site_laravel_maintenance_integration resource, which puts a
+// site in Laravel maintenance mode. Destroying it disables the integration.
+func NewSiteLaravelMaintenanceIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_laravel_maintenance_integration",
+		schema: resource_site_laravel_maintenance_integration.SiteLaravelMaintenanceIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/laravel-maintenance"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/laravel-maintenance"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/laravel-maintenance"},
+		exists: integrationEnabled,
+
+		bodyFields: map[string]string{"status_code": "status"},
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_laravel_scheduler_integration"
+)
+
+// NewSiteLaravelSchedulerIntegrationResource returns the
+// laravelforge_site_laravel_scheduler_integration resource, which runs the
+// Laravel scheduler of a site. Destroying it disables the integration.
+func NewSiteLaravelSchedulerIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_laravel_scheduler_integration",
+		schema: resource_site_laravel_scheduler_integration.SiteLaravelSchedulerIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/laravel-scheduler"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/laravel-scheduler"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/laravel-scheduler"},
+		exists: integrationEnabled,
+	}
+}
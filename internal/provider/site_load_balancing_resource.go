@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_load_balancing"
+)
+
+// siteLoadBalancingResource manages the nodes a load balancer site balances
+// requests over. The API replaces all nodes at once and lists them as
+// resources of their own, so the nodes are read from that list.
+type siteLoadBalancingResource struct {
+	*apiResource
+}
+
+// NewSiteLoadBalancingResource returns the laravelforge_site_load_balancing
+// resource, which manages the nodes of a load balancer site.
+func NewSiteLoadBalancingResource() resource.Resource {
+	return &siteLoadBalancingResource{&apiResource{
+		name:   "site_load_balancing",
+		schema: resource_site_load_balancing.SiteLoadBalancingResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/load-balancing-nodes"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/load-balancing-nodes"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/load-balancing-nodes"},
+	}}
+}
+
+func (r *siteLoadBalancingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.write(ctx, req.Plan.Raw, "create", &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *siteLoadBalancingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.write(ctx, req.Plan.Raw, "update", &resp.State.Raw, &resp.Diagnostics)
+}
+
+// write sends the nodes of the plan to the API and reads them back. The API
+// applies them asynchronously, so nodes keep their planned values and only
+// the settings left to the API are taken from the nodes it already lists.
+func (r *siteLoadBalancingResource) write(ctx context.Context, planned tftypes.Value, action string, state *tftypes.Value, diags *diag.Diagnostics) {
+	plan, err := objectValues(planned)
+	if err != nil {
+		diags.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	body, err := r.requestBody(plan, nil)
+	if err != nil {
+		diags.AddError("Unable to build request", err.Error())
+
+		return
+	}
+
+	if _, ok := r.do(ctx, *r.update, plan, body, action, diags); !ok {
+		return
+	}
+
+	resources, err := r.nodes(ctx, plan)
+	if err != nil {
+		diags.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	if plan["balancing"], err = readNodes(plan["balancing"], resources); err != nil {
+		diags.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	for name, v := range plan {
+		plan[name] = nullUnknowns(v)
+	}
+
+	*state = tftypes.NewValue(planned.Type(), plan)
+}
+
+// nodes lists the nodes of the load balancer site in values.
+func (r *siteLoadBalancingResource) nodes(ctx context.Context, values map[string]tftypes.Value) ([]forge.Resource, error) {
+	listPath, err := r.path(r.read, values)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.ListAll(ctx, listPath, nil)
+}
+
+// Read refreshes the nodes from the list of the API. Nodes keep the order
+// of the state, so reordering them in the API does not show up as a change.
+// The resource is removed from the state when the site has no nodes.
+func (r *siteLoadBalancingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	resources, err := r.nodes(ctx, state)
+	if err != nil {
+		if forge.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	if len(resources) == 0 {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	previous, err := toJSON(state["balancing"])
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("balancing"), "Invalid value", err.Error())
+
+		return
+	}
+
+	list, _ := previous.([]any)
+	sort.SliceStable(resources, func(i, j int) bool {
+		return nodeIndex(list, resources[i]) < nodeIndex(list, resources[j])
+	})
+
+	balancing := make([]any, 0, len(resources))
+	for _, resource := range resources {
+		balancing = append(balancing, resource.Attributes)
+	}
+
+	if state["balancing"], err = fromJSON(state["balancing"].Type(), balancing); err != nil {
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	resp.State.Raw = tftypes.NewValue(req.State.Raw.Type(), state)
+}
+
+// readNodes returns the planned nodes with the settings left unknown in the
+// plan taken from the node of the same server in resources, when the API
+// lists it already.
+func readNodes(planned tftypes.Value, resources []forge.Resource) (tftypes.Value, error) {
+	var elems []tftypes.Value
+	if err := planned.As(&elems); err != nil {
+		return planned, err
+	}
+
+	elemType := planned.Type().(tftypes.List).ElementType
+
+	for i, elem := range elems {
+		node, err := objectValues(elem)
+		if err != nil {
+			return planned, err
+		}
+
+		serverID, err := toJSON(node["server_id"])
+		if err != nil {
+			return planned, err
+		}
+
+		for _, resource := range resources {
+			if fmt.Sprint(resource.Attributes["server_id"]) != fmt.Sprint(serverID) {
+				continue
+			}
+
+			read, err := fromJSON(elemType, resource.Attributes)
+			if err != nil {
+				return planned, err
+			}
+
+			values, err := objectValues(read)
+			if err != nil {
+				return planned, err
+			}
+
+			for name, v := range node {
+				if !v.IsKnown() {
+					node[name] = values[name]
+				}
+			}
+		}
+
+		elems[i] = tftypes.NewValue(elemType, node)
+	}
+
+	return tftypes.NewValue(planned.Type(), elems), nil
+}
+
+// nodeIndex returns the index of the node of the same server in a list of
+// nodes, or the length of the list when it has no node of the server.
+func nodeIndex(list []any, node forge.Resource) int {
+	serverID := fmt.Sprint(node.Attributes["server_id"])
+
+	for i, item := range list {
+		if other, _ := item.(map[string]any); fmt.Sprint(other["server_id"]) == serverID {
+			return i
+		}
+	}
+
+	return len(list)
+}
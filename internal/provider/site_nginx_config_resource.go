@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_nginx_config"
+)
+
+// NewSiteNginxConfigResource returns the laravelforge_site_nginx_config resource,
+// which manages the nginx configuration of a site.
+func NewSiteNginxConfigResource() resource.Resource {
+	return &nginxConfigResource{&apiResource{
+		name:   "site_nginx_config",
+		schema: resource_site_nginx_config.SiteNginxConfigResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/nginx"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/nginx"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}/nginx"},
+
+		bodyFields: map[string]string{"content": "config"},
+		equal:      map[string]func(string, string) bool{"content": sameIgnoringWhitespace},
+	}}
+}
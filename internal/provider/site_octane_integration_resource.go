@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_octane_integration"
+)
+
+// NewSiteOctaneIntegrationResource returns the
+// laravelforge_site_octane_integration resource, which runs a site with
+// Laravel Octane. Destroying it disables the integration.
+func NewSiteOctaneIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_octane_integration",
+		schema: resource_site_octane_integration.SiteOctaneIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/octane"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/octane"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/octane"},
+		exists: integrationEnabled,
+
+		bodyFields: map[string]string{"octane_server": "server"},
+	}
+}
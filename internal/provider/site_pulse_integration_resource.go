@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_pulse_integration"
+)
+
+// NewSitePulseIntegrationResource returns the
+// laravelforge_site_pulse_integration resource, which enables Laravel Pulse
+// on a site. Destroying it disables the integration.
+func NewSitePulseIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_pulse_integration",
+		schema: resource_site_pulse_integration.SitePulseIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/pulse"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/pulse"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/pulse"},
+		exists: integrationEnabled,
+	}
+}
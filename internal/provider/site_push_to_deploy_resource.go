@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/forge"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_push_to_deploy"
+)
+
+// sitePushToDeployResource enables deploying a site when its branch is
+// pushed to. The API has no resource of its own for it, so it is read from
+// the quick_deploy attribute and the repository of the site, and the branch
+// is changed by updating the site.
+type sitePushToDeployResource struct {
+	*apiResource
+}
+
+// NewSitePushToDeployResource returns the laravelforge_site_push_to_deploy
+// resource, which enables push to deploy on a site. Destroying it disables
+// push to deploy.
+func NewSitePushToDeployResource() resource.Resource {
+	return &sitePushToDeployResource{&apiResource{
+		name:   "site_push_to_deploy",
+		schema: resource_site_push_to_deploy.SitePushToDeployResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/push-to-deploy"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/sites/{site}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/deployments/push-to-deploy"},
+	}}
+}
+
+func (r *sitePushToDeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	if _, ok := r.do(ctx, r.create, plan, nil, "create", &resp.Diagnostics); !ok {
+		return
+	}
+
+	r.write(ctx, req.Plan.Raw, plan, "create", &resp.State.Raw, &resp.Diagnostics)
+}
+
+func (r *sitePushToDeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	r.write(ctx, req.Plan.Raw, plan, "update", &resp.State.Raw, &resp.Diagnostics)
+}
+
+// write sets the branch of the site when the plan has one and stores the
+// plan in the state, with the branch of the site when it has none.
+func (r *sitePushToDeployResource) write(ctx context.Context, planned tftypes.Value, plan map[string]tftypes.Value, action string, state *tftypes.Value, diags *diag.Diagnostics) {
+	if isSet(plan["branch"]) {
+		body, err := r.requestBody(plan, map[string]string{"branch": "repository_branch"})
+		if err != nil {
+			diags.AddError("Unable to build request", err.Error())
+
+			return
+		}
+
+		// Updating the site without push_to_deploy disables it.
+		body["push_to_deploy"] = true
+
+		if _, ok := r.do(ctx, *r.update, plan, body, action, diags); !ok {
+			return
+		}
+	} else {
+		data, ok := r.do(ctx, r.read, plan, nil, "read", diags)
+		if !ok {
+			return
+		}
+
+		plan["branch"] = tftypes.NewValue(tftypes.String, siteBranch(data))
+	}
+
+	for name, v := range plan {
+		plan[name] = nullUnknowns(v)
+	}
+
+	*state = tftypes.NewValue(planned.Type(), plan)
+}
+
+// Read refreshes the branch from the site, which is removed from the state
+// when push to deploy was disabled.
+func (r *sitePushToDeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state, err := objectValues(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read state", err.Error())
+
+		return
+	}
+
+	readPath, err := r.path(r.read, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	var doc struct {
+		Data map[string]any `json:"data"`
+	}
+
+	if err := r.client.Get(ctx, readPath, nil, &doc); err != nil {
+		if forge.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Unable to read "+r.typeName, err.Error())
+
+		return
+	}
+
+	attributes, _ := doc.Data["attributes"].(map[string]any)
+	if enabled, _ := attributes["quick_deploy"].(bool); !enabled {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state["branch"] = tftypes.NewValue(tftypes.String, siteBranch(doc.Data))
+
+	resp.State.Raw = tftypes.NewValue(req.State.Raw.Type(), state)
+}
+
+// siteBranch returns the branch of the repository of a site, or nil when
+// the site has no repository.
+func siteBranch(data map[string]any) any {
+	attributes, _ := data["attributes"].(map[string]any)
+	repository, _ := attributes["repository"].(map[string]any)
+
+	if branch, ok := repository["branch"].(string); ok {
+		return branch
+	}
+
+	return nil
+}
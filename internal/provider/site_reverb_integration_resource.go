@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_reverb_integration"
+)
+
+// NewSiteReverbIntegrationResource returns the
+// laravelforge_site_reverb_integration resource, which runs a Laravel Reverb
+// server for a site. Destroying it disables the integration.
+func NewSiteReverbIntegrationResource() resource.Resource {
+	return &apiResource{
+		name:   "site_reverb_integration",
+		schema: resource_site_reverb_integration.SiteReverbIntegrationResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/reverb"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/reverb"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/integrations/reverb"},
+		exists: integrationEnabled,
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_site_scheduled_jobs"
+)
+
+// NewSiteScheduledJobsResource returns the laravelforge_site_scheduled_jobs
+// resource, which manages a scheduled job of a site.
+func NewSiteScheduledJobsResource() resource.Resource {
+	return &apiResource{
+		name:   "site_scheduled_jobs",
+		schema: resource_site_scheduled_jobs.SiteScheduledJobsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites/{site}/scheduled-jobs"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/sites/{site}/scheduled-jobs/{job}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}/scheduled-jobs/{job}"},
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_sites"
+)
+
+// sitesResource manages a site. Updating a site without push_to_deploy
+// disables push to deploy, which the laravelforge_site_push_to_deploy
+// resource manages, so updates send the current setting of the site.
+type sitesResource struct {
+	*apiResource
+}
+
+// NewSitesResource returns the laravelforge_sites resource, which manages a
+// site on a server.
+func NewSitesResource() resource.Resource {
+	return &sitesResource{&apiResource{
+		name:   "sites",
+		schema: resource_sites.SitesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/sites"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/sites/{site}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/servers/{server}/sites/{site}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/sites/{site}"},
+
+		// The update operation names the attributes differently from the
+		// create operation.
+		updateFields: map[string]string{
+			"type":           "type",
+			"root_directory": "root_path",
+			"web_directory":  "directory",
+			"php_version":    "php_version",
+			"branch":         "repository_branch",
+		},
+	}}
+}
+
+func (r *sitesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, err := objectValues(req.Plan.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read plan", err.Error())
+
+		return
+	}
+
+	body, err := r.requestBody(plan, r.updateFields)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build request", err.Error())
+
+		return
+	}
+
+	current, ok := r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	attributes, _ := current["attributes"].(map[string]any)
+	body["push_to_deploy"], _ = attributes["quick_deploy"].(bool)
+
+	data, ok := r.do(ctx, *r.update, plan, body, "update", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if data == nil {
+		if data, ok = r.do(ctx, r.read, plan, nil, "read", &resp.Diagnostics); !ok {
+			return
+		}
+	}
+
+	r.setState(req.Plan.Raw, data, false, &resp.State.Raw, &resp.Diagnostics)
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_ssh_keys"
+)
+
+// NewSshKeysResource returns the laravelforge_ssh_keys resource, which
+// manages an SSH key authorized on a server.
+func NewSshKeysResource() resource.Resource {
+	return &apiResource{
+		name:   "ssh_keys",
+		schema: resource_ssh_keys.SshKeysResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/servers/{server}/ssh-keys"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/servers/{server}/ssh-keys/{key}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/servers/{server}/ssh-keys/{key}"},
+
+		aliases: map[string]string{"key": "key_id"},
+	}
+}
@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_team_invites"
+)
+
+// NewTeamInvitesResource returns the laravelforge_team_invites resource,
+// which manages an invitation to join a team.
+func NewTeamInvitesResource() resource.Resource {
+	return &apiResource{
+		name:   "team_invites",
+		schema: resource_team_invites.TeamInvitesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/teams/{team}/invites"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/teams/{team}/invites/{invitation}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/teams/{team}/invites/{invitation}"},
+	}
+}
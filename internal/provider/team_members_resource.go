@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_team_members"
+)
+
+// NewTeamMembersResource returns the laravelforge_team_members resource,
+// which adds a member of the organization to a team with a role. Destroying
+// it removes the member from the team.
+func NewTeamMembersResource() resource.Resource {
+	return &apiResource{
+		name:   "team_members",
+		schema: resource_team_members.TeamMembersResourceSchema,
+		create: operation{method: http.MethodPut, path: "/orgs/{organization}/teams/{team}/members/{user}"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/teams/{team}/members/{user}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/teams/{team}/members/{user}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/teams/{team}/members/{user}"},
+
+		relationships: map[string]string{"role_id": "role"},
+	}
+}
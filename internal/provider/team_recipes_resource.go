@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_team_recipes"
+)
+
+// NewTeamRecipesResource returns the laravelforge_team_recipes resource,
+// which shares a recipe with a team. Destroying it stops sharing it.
+func NewTeamRecipesResource() resource.Resource {
+	return &listedResource{&apiResource{
+		name:   "team_recipes",
+		schema: resource_team_recipes.TeamRecipesResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/teams/{team}/recipes"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/teams/{team}/recipes"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/teams/{team}/recipes/{recipe}"},
+
+		aliases: map[string]string{"recipe": "recipe_id"},
+	}}
+}
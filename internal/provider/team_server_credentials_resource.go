@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_team_server_credentials"
+)
+
+// NewTeamServerCredentialsResource returns the
+// laravelforge_team_server_credentials resource, which shares a server
+// credential with a team. Destroying it stops sharing it.
+func NewTeamServerCredentialsResource() resource.Resource {
+	return &listedResource{&apiResource{
+		name:   "team_server_credentials",
+		schema: resource_team_server_credentials.TeamServerCredentialsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/teams/{team}/server-credentials"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/teams/{team}/server-credentials"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/teams/{team}/server-credentials/{credential}"},
+
+		aliases: map[string]string{"credential": "credential_id"},
+	}}
+}
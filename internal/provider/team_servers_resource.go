@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_team_servers"
+)
+
+// NewTeamServersResource returns the laravelforge_team_servers resource,
+// which shares a server with a team. Destroying it stops sharing it.
+func NewTeamServersResource() resource.Resource {
+	return &listedResource{&apiResource{
+		name:   "team_servers",
+		schema: resource_team_servers.TeamServersResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/teams/{team}/servers"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/teams/{team}/servers"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/teams/{team}/servers/{server}"},
+
+		aliases: map[string]string{"server": "server_id"},
+	}}
+}
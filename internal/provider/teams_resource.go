@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/madewithlove/terraform-provider-laravelforge/internal/provider/resource_teams"
+)
+
+// NewTeamsResource returns the laravelforge_teams resource, which manages a
+// team of the organization.
+func NewTeamsResource() resource.Resource {
+	return &apiResource{
+		name:   "teams",
+		schema: resource_teams.TeamsResourceSchema,
+		create: operation{method: http.MethodPost, path: "/orgs/{organization}/teams"},
+		read:   operation{method: http.MethodGet, path: "/orgs/{organization}/teams/{team}"},
+		update: &operation{method: http.MethodPut, path: "/orgs/{organization}/teams/{team}"},
+		delete: &operation{method: http.MethodDelete, path: "/orgs/{organization}/teams/{team}"},
+
+		updateFields: map[string]string{
+			"name":  "name",
+			"users": "users",
+		},
+	}
+}
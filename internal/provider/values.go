@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// objectValues returns a copy of the attributes of an object value, which
+// is empty when the value is null or unknown. The map returned by As is the
+// one of the value itself, so it must not be modified.
+func objectValues(v tftypes.Value) (map[string]tftypes.Value, error) {
+	values := map[string]tftypes.Value{}
+
+	if v.IsNull() || !v.IsKnown() {
+		return values, nil
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := v.As(&attributes); err != nil {
+		return nil, err
+	}
+
+	for name, value := range attributes {
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// toJSON converts a Terraform value into a value that encodes to the
+// equivalent JSON. Null and unknown values, including object attributes,
+// are returned as nil so they can be left out of request bodies.
+func toJSON(v tftypes.Value) (any, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+
+	typ := v.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		err := v.As(&s)
+
+		return s, err
+	case typ.Is(tftypes.Bool):
+		var b bool
+		err := v.As(&b)
+
+		return b, err
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+
+		if n.IsInt() {
+			if i, accuracy := n.Int64(); accuracy == big.Exact {
+				return i, nil
+			}
+		}
+
+		f, _ := n.Float64()
+
+		return f, nil
+	}
+
+	switch typ.(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+
+		list := make([]any, 0, len(elems))
+
+		for _, elem := range elems {
+			value, err := toJSON(elem)
+			if err != nil {
+				return nil, err
+			}
+
+			list = append(list, value)
+		}
+
+		return list, nil
+	case tftypes.Map, tftypes.Object:
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return nil, err
+		}
+
+		m := make(map[string]any, len(attrs))
+
+		for name, attr := range attrs {
+			value, err := toJSON(attr)
+			if err != nil {
+				return nil, err
+			}
+
+			if value != nil {
+				m[name] = value
+			}
+		}
+
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// fromJSON converts a decoded JSON value into a Terraform value of the given
+// type. Scalars are converted between strings, numbers and booleans where
+// the API is loose about their representation. Object attributes missing
+// from the JSON are null.
+func fromJSON(typ tftypes.Type, v any) (tftypes.Value, error) {
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		switch x := v.(type) {
+		case string:
+			return tftypes.NewValue(typ, x), nil
+		case json.Number:
+			return tftypes.NewValue(typ, x.String()), nil
+		case bool:
+			return tftypes.NewValue(typ, strconv.FormatBool(x)), nil
+		}
+	case typ.Is(tftypes.Number):
+		var s string
+
+		switch x := v.(type) {
+		case json.Number:
+			s = x.String()
+		case string:
+			s = x
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(x)), nil
+		}
+
+		if n, ok := new(big.Float).SetString(s); ok {
+			return tftypes.NewValue(typ, n), nil
+		}
+	case typ.Is(tftypes.Bool):
+		switch x := v.(type) {
+		case bool:
+			return tftypes.NewValue(typ, x), nil
+		case string:
+			if b, err := strconv.ParseBool(x); err == nil {
+				return tftypes.NewValue(typ, b), nil
+			}
+		case json.Number:
+			return tftypes.NewValue(typ, x.String() != "0"), nil
+		}
+	}
+
+	switch t := typ.(type) {
+	case tftypes.List, tftypes.Set:
+		list, ok := v.([]any)
+		if !ok {
+			break
+		}
+
+		var elemType tftypes.Type
+
+		if l, ok := t.(tftypes.List); ok {
+			elemType = l.ElementType
+		} else {
+			elemType = t.(tftypes.Set).ElementType //nolint:forcetypeassert
+		}
+
+		elems := make([]tftypes.Value, 0, len(list))
+
+		for _, item := range list {
+			elem, err := fromJSON(elemType, item)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elems = append(elems, elem)
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Map:
+		m, ok := v.(map[string]any)
+		if !ok {
+			break
+		}
+
+		elems := make(map[string]tftypes.Value, len(m))
+
+		for k, item := range m {
+			elem, err := fromJSON(t.ElementType, item)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+			}
+
+			elems[k] = elem
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case tftypes.Object:
+		m, ok := v.(map[string]any)
+		if !ok {
+			// An empty JSON:API object such as "meta" may be sent as [].
+			if list, isList := v.([]any); isList && len(list) == 0 {
+				m = map[string]any{}
+			} else {
+				break
+			}
+		}
+
+		attrs := make(map[string]tftypes.Value, len(t.AttributeTypes))
+
+		for _, name := range sortedKeys(t.AttributeTypes) {
+			attr, err := fromJSON(t.AttributeTypes[name], m[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+
+			attrs[name] = attr
+		}
+
+		return tftypes.NewValue(typ, attrs), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("cannot convert %T to %s", v, typ)
+}
+
+// sameText reports whether two texts, such as files and scripts, only
+// differ in line endings and trailing blank lines.
+func sameText(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.TrimRight(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	}
+
+	return normalize(a) == normalize(b)
+}
+
+// sameIgnoringWhitespace reports whether two texts only differ in
+// whitespace, such as indentation and blank lines.
+func sameIgnoringWhitespace(a, b string) bool {
+	return strings.Join(strings.Fields(a), " ") == strings.Join(strings.Fields(b), " ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}